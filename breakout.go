@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// readBreakoutRooms reads breakout room assignments from a CSV file with a
+// room and an email column, one participant per row, optionally under a
+// "room,email" header row. Rooms keep the order they first appear in.
+func readBreakoutRooms(path string) ([]zoom.BreakoutRoom, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening breakout rooms file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var rooms []zoom.BreakoutRoom
+	roomIndex := map[string]int{}
+	assigned := map[string]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		name, email := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if line == 1 && strings.EqualFold(name, "room") && strings.EqualFold(email, "email") {
+			continue
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("%s:%d: room name is empty", path, line)
+		}
+		if !isEmail(email) {
+			return nil, fmt.Errorf("%s:%d: invalid email %q", path, line, email)
+		}
+		key := strings.ToLower(email)
+		if room, ok := assigned[key]; ok {
+			return nil, fmt.Errorf("%s:%d: %s is already assigned to room %q", path, line, email, room)
+		}
+		assigned[key] = name
+
+		i, ok := roomIndex[name]
+		if !ok {
+			i = len(rooms)
+			roomIndex[name] = i
+			rooms = append(rooms, zoom.BreakoutRoom{Name: name})
+		}
+		rooms[i].Participants = append(rooms[i].Participants, email)
+	}
+
+	if len(rooms) == 0 {
+		return nil, fmt.Errorf("%s has no breakout room assignments", path)
+	}
+	return rooms, nil
+}
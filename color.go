@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fatih/color"
+	"golang.org/x/term"
+)
+
+// colorMode is the --color setting: auto, always or never.
+var colorMode = "auto"
+
+// Colors of the parts of the output worth finding at a glance.
+var (
+	linkColor     = color.New(color.FgCyan, color.Underline)
+	passcodeColor = color.New(color.Bold)
+	warningColor  = color.New(color.FgYellow)
+	errorColor    = color.New(color.FgRed, color.Bold)
+)
+
+func validateColorMode(mode string) error {
+	switch mode {
+	case "auto", "always", "never":
+		return nil
+	}
+	return fmt.Errorf("invalid --color value %q: must be auto, always or never", mode)
+}
+
+// colorEnabled reports whether text written to w is colored: always with
+// --color always, never with --color never, and otherwise when w is a
+// terminal and NO_COLOR is not set. Files and pipes stay plain.
+func colorEnabled(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	switch colorMode {
+	case "always":
+		return true
+	case "never":
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// paint returns s in color c if text written to w is colored, otherwise s.
+func paint(w io.Writer, c *color.Color, s string) string {
+	if s == "" || !colorEnabled(w) {
+		return s
+	}
+
+	// The package's own NO_COLOR and terminal checks look at stdout only,
+	// so colorEnabled decides instead
+	c.EnableColor()
+	return c.Sprint(s)
+}
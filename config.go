@@ -0,0 +1,426 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/internal/atomicfile"
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// storedConfig is the content of the config file. It holds either a single
+// account at the top level (the original format) or named profiles:
+//
+//	{"profiles": {"work": {"account_id": ...}, "personal": {...}}}
+type storedConfig struct {
+	zoom.OAuthConfig
+	Profiles map[string]profileConfig `json:"profiles,omitempty"`
+
+	// DefaultProfile is used when no --profile is given. It may be a
+	// profile's name or alias.
+	DefaultProfile string `json:"default_profile,omitempty"`
+
+	// Defaults apply to meetings created with any of the accounts.
+	Defaults Defaults `json:"defaults"`
+}
+
+// profileConfig is one named account of the config file. Alias is a second
+// name it can be selected by, e.g. a short one.
+type profileConfig struct {
+	zoom.OAuthConfig
+	Alias string `json:"alias,omitempty"`
+}
+
+// Defaults are the config file's own meeting defaults, e.g.
+//
+//	{"defaults": {"duration": 30, "topic": "Sync"}}
+//
+// They replace the built-in defaults; a template and flags override them.
+type Defaults struct {
+	Topic    string                `json:"topic,omitempty"`
+	Type     int                   `json:"type,omitempty"`
+	Duration int                   `json:"duration,omitempty"`
+	Timezone string                `json:"timezone,omitempty"`
+	Password string                `json:"password,omitempty"`
+	Agenda   string                `json:"agenda,omitempty"`
+	Settings *zoom.MeetingSettings `json:"settings,omitempty"`
+
+	// OnSuccess is the --on-success command used when none is given.
+	OnSuccess string `json:"on_success,omitempty"`
+
+	// SlackWebhook and SlackTemplate are the --slack-webhook and
+	// --slack-template used when none is given.
+	SlackWebhook  string `json:"slack_webhook,omitempty"`
+	SlackTemplate string `json:"slack_template,omitempty"`
+
+	// Transcription turns on --transcription unless the flag or --recording
+	// is given.
+	Transcription bool `json:"transcription,omitempty"`
+}
+
+// apply returns details with every field set in d replaced.
+func (d Defaults) apply(details zoom.MeetingDetails) zoom.MeetingDetails {
+	if d.Topic != "" {
+		details.Topic = d.Topic
+	}
+	if d.Type != 0 {
+		details.Type = d.Type
+	}
+	if d.Duration != 0 {
+		details.Duration = d.Duration
+	}
+	if d.Timezone != "" {
+		details.Timezone = d.Timezone
+	}
+	if d.Password != "" {
+		details.Password = d.Password
+	}
+	if d.Agenda != "" {
+		details.Agenda = d.Agenda
+	}
+	if d.Settings != nil {
+		details.Settings = d.Settings
+	}
+	return details
+}
+
+// configPath picks the config file from the --config flag, then the
+// ZOOM_MEETING_CONFIG environment variable, then ~/.zoom-meeting.config.json.
+func configPath(flagPath string) (string, error) {
+	if flagPath != "" {
+		return flagPath, nil
+	}
+
+	if envPath := os.Getenv("ZOOM_MEETING_CONFIG"); envPath != "" {
+		return envPath, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("finding user home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".zoom-meeting.config.json"), nil
+}
+
+// tokenCachePath is where OAuth tokens are cached between runs.
+func tokenCachePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".zoom-meeting.token.json"), nil
+}
+
+// Environment variables that supply or override the OAuth credentials.
+const (
+	envAccountID    = "ZOOM_ACCOUNT_ID"
+	envClientID     = "ZOOM_CLIENT_ID"
+	envClientSecret = "ZOOM_CLIENT_SECRET"
+)
+
+// loadOAuthConfig returns the account to use. It comes from the config
+// file, with ZOOM_ACCOUNT_ID, ZOOM_CLIENT_ID and ZOOM_CLIENT_SECRET
+// overriding the file's values. With --env, or when there is no config
+// file but credentials are set in the environment, the file is not read.
+// A client secret of the form "keyring:service/user" is read from the OS
+// keychain.
+func loadOAuthConfig(opts globalOptions) (zoom.OAuthConfig, error) {
+	var config zoom.OAuthConfig
+
+	if !opts.Env {
+		configFile, err := configPath(opts.Config)
+		if err != nil {
+			return zoom.OAuthConfig{}, err
+		}
+
+		_, statErr := os.Stat(configFile)
+		if !errors.Is(statErr, fs.ErrNotExist) || !hasEnvCredentials() {
+			if err := checkConfigPermissions(configFile, opts.InsecureConfig); err != nil {
+				return zoom.OAuthConfig{}, err
+			}
+			config, err = readConfigFile(configFile, opts.Profile)
+			if err != nil {
+				return zoom.OAuthConfig{}, err
+			}
+		}
+	}
+
+	applyEnvCredentials(&config)
+
+	if err := validateConfig(config); err != nil {
+		return zoom.OAuthConfig{}, err
+	}
+
+	secret, err := resolveKeyringSecret(config.ClientSecret)
+	if err != nil {
+		return zoom.OAuthConfig{}, fmt.Errorf("client_secret: %w", err)
+	}
+	config.ClientSecret = secret
+
+	return config, nil
+}
+
+// validateConfig reports every missing credential at once, naming the
+// config field and the environment variable that would supply it.
+func validateConfig(config zoom.OAuthConfig) error {
+	var errs []error
+	switch config.GrantType {
+	case "", zoom.GrantAccountCredentials:
+		if config.AccountID == "" {
+			errs = append(errs, fmt.Errorf("account_id is missing (or set %s)", envAccountID))
+		}
+	case zoom.GrantAuthorizationCode:
+		if config.RefreshToken == "" {
+			errs = append(errs, errors.New("refresh_token is missing, which the authorization_code grant needs"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unsupported grant_type %q: must be %s or %s", config.GrantType, zoom.GrantAccountCredentials, zoom.GrantAuthorizationCode))
+	}
+	if config.ClientID == "" {
+		errs = append(errs, fmt.Errorf("client_id is missing (or set %s)", envClientID))
+	}
+	if config.ClientSecret == "" {
+		errs = append(errs, fmt.Errorf("client_secret is missing (or set %s)", envClientSecret))
+	}
+	return errors.Join(errs...)
+}
+
+// readConfigFile reads the account from the config file. profile selects
+// a named profile and must be empty for a flat config.
+func readConfigFile(configFile, profile string) (zoom.OAuthConfig, error) {
+	file, err := readStoredConfig(configFile)
+	if err != nil {
+		return zoom.OAuthConfig{}, err
+	}
+
+	name, err := resolveProfile(file, profile)
+	if err != nil {
+		return zoom.OAuthConfig{}, fmt.Errorf("%s: %w", configFile, err)
+	}
+	if name == "" {
+		return file.OAuthConfig, nil
+	}
+
+	return file.Profiles[name].OAuthConfig, nil
+}
+
+// readStoredConfig reads and parses the config file.
+func readStoredConfig(configFile string) (storedConfig, error) {
+	fileContent, err := os.ReadFile(configFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return storedConfig{}, fmt.Errorf("config file %s does not exist", configFile)
+	}
+	if err != nil {
+		return storedConfig{}, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var file storedConfig
+	if err := json.Unmarshal(fileContent, &file); err != nil {
+		return storedConfig{}, fmt.Errorf("parsing config file %s: %w", configFile, describeJSONError(fileContent, err))
+	}
+
+	return file, nil
+}
+
+// saveRefreshToken stores a rotated refresh token in the config file, in
+// the profile it was read from. Other content of the file is kept, though
+// its keys are rewritten in sorted order. The file is replaced in one
+// step, as the old token no longer works and a half-written file would
+// lose both.
+func saveRefreshToken(configFile, profile, refreshToken string) error {
+	fileContent, err := os.ReadFile(configFile)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	var stored storedConfig
+	if err := json.Unmarshal(fileContent, &stored); err != nil {
+		return fmt.Errorf("parsing config file %s: %w", configFile, describeJSONError(fileContent, err))
+	}
+	profile, err = resolveProfile(stored, profile)
+	if err != nil {
+		return fmt.Errorf("%s: %w", configFile, err)
+	}
+
+	var file map[string]interface{}
+	if err := json.Unmarshal(fileContent, &file); err != nil {
+		return fmt.Errorf("parsing config file %s: %w", configFile, describeJSONError(fileContent, err))
+	}
+
+	account := file
+	if profile != "" {
+		profiles, _ := file["profiles"].(map[string]interface{})
+		account, _ = profiles[profile].(map[string]interface{})
+		if account == nil {
+			return fmt.Errorf("%s: profile %q not found", configFile, profile)
+		}
+	}
+	account["refresh_token"] = refreshToken
+
+	fileContent, err = json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if err := atomicfile.WriteFile(configFile, append(fileContent, '\n')); err != nil {
+		return fmt.Errorf("writing config file: %w", err)
+	}
+	return nil
+}
+
+// loadDefaults returns the meeting defaults from the config file. There
+// are none with --env or when the file does not exist.
+func loadDefaults(opts globalOptions) (Defaults, error) {
+	if opts.Env {
+		return Defaults{}, nil
+	}
+
+	configFile, err := configPath(opts.Config)
+	if err != nil {
+		return Defaults{}, err
+	}
+
+	fileContent, err := os.ReadFile(configFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Defaults{}, nil
+	}
+	if err != nil {
+		return Defaults{}, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var file storedConfig
+	if err := json.Unmarshal(fileContent, &file); err != nil {
+		return Defaults{}, fmt.Errorf("parsing config file %s: %w", configFile, describeJSONError(fileContent, err))
+	}
+
+	return file.Defaults, nil
+}
+
+func hasEnvCredentials() bool {
+	return os.Getenv(envAccountID) != "" || os.Getenv(envClientID) != "" || os.Getenv(envClientSecret) != ""
+}
+
+// applyEnvCredentials overrides config with any credentials set in the
+// environment.
+func applyEnvCredentials(config *zoom.OAuthConfig) {
+	if v := os.Getenv(envAccountID); v != "" {
+		config.AccountID = v
+	}
+	if v := os.Getenv(envClientID); v != "" {
+		config.ClientID = v
+	}
+	if v := os.Getenv(envClientSecret); v != "" {
+		config.ClientSecret = v
+	}
+}
+
+// resolveProfile returns the name of the profile that profile, a name or
+// alias, selects, falling back to the file's default_profile. It returns
+// "" for a flat config, which has no profiles.
+func resolveProfile(file storedConfig, profile string) (string, error) {
+	if file.Profiles == nil {
+		if profile != "" {
+			return "", fmt.Errorf("--profile %q given but the config file has no profiles; move the account under {\"profiles\": {%q: {...}}}", profile, profile)
+		}
+		return "", nil
+	}
+
+	if err := checkAliases(file); err != nil {
+		return "", err
+	}
+
+	if profile == "" {
+		if file.DefaultProfile == "" {
+			return "", fmt.Errorf("the config file has profiles, choose one with --profile or set default_profile (%s)", strings.Join(profileNames(file), ", "))
+		}
+		name, ok := findProfile(file, file.DefaultProfile)
+		if !ok {
+			return "", fmt.Errorf("default_profile %q is not one of the profiles: %s", file.DefaultProfile, strings.Join(profileNames(file), ", "))
+		}
+		return name, nil
+	}
+
+	name, ok := findProfile(file, profile)
+	if !ok {
+		return "", fmt.Errorf("profile %q not found, available profiles: %s", profile, strings.Join(profileNames(file), ", "))
+	}
+
+	return name, nil
+}
+
+// findProfile looks profile up by name, then by alias.
+func findProfile(file storedConfig, profile string) (string, bool) {
+	if _, ok := file.Profiles[profile]; ok {
+		return profile, true
+	}
+	for name, p := range file.Profiles {
+		if p.Alias == profile {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// checkAliases rejects aliases that would make a name ambiguous.
+func checkAliases(file storedConfig) error {
+	owner := map[string]string{}
+	for _, name := range profileNames(file) {
+		alias := file.Profiles[name].Alias
+		if alias == "" {
+			continue
+		}
+		if _, ok := file.Profiles[alias]; ok && alias != name {
+			return fmt.Errorf("alias %q of profile %q is also the name of a profile", alias, name)
+		}
+		if other, ok := owner[alias]; ok {
+			return fmt.Errorf("profiles %q and %q have the same alias %q", other, name, alias)
+		}
+		owner[alias] = name
+	}
+	return nil
+}
+
+func profileNames(file storedConfig) []string {
+	names := make([]string, 0, len(file.Profiles))
+	for name := range file.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// describeJSONError adds the line and column to syntax and type errors,
+// which encoding/json only reports as the offset just past the problem.
+func describeJSONError(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	var offset int64
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return err
+	}
+
+	line, column := 1, 1
+	for _, b := range data[:min(max(int(offset)-1, 0), len(data))] {
+		if b == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	return fmt.Errorf("line %d, column %d: %w", line, column, err)
+}
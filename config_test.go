@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveRefreshToken(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config.json")
+	content := `{
+    "default_profile": "work",
+    "profiles": {
+        "work": {"client_id": "id", "client_secret": "secret", "grant_type": "authorization_code", "refresh_token": "old"}
+    },
+    "defaults": {"duration": 30}
+}
+`
+	if err := os.WriteFile(configFile, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := saveRefreshToken(configFile, "", "new"); err != nil {
+		t.Fatalf("saveRefreshToken: %v", err)
+	}
+
+	stored, err := readStoredConfig(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := stored.Profiles["work"].RefreshToken; got != "new" {
+		t.Errorf("refresh_token = %q, want new", got)
+	}
+	if stored.Profiles["work"].ClientSecret != "secret" || stored.Defaults.Duration != 30 {
+		t.Errorf("the rest of the config file changed: %+v", stored)
+	}
+
+	info, err := os.Stat(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("config file mode = %o, want 600", perm)
+	}
+
+	// Nothing but the config file is left behind
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		t.Errorf("directory holds %v, want only config.json", names)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package main
+
+// checkConfigPermissions is a no-op where file modes do not describe who
+// can read a file.
+func checkConfigPermissions(configFile string, insecure bool) error {
+	return nil
+}
@@ -0,0 +1,30 @@
+//go:build unix
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// checkConfigPermissions refuses a config file that other users can read,
+// since it holds the client secret, unless insecure is set, in which case
+// it only warns.
+func checkConfigPermissions(configFile string, insecure bool) error {
+	info, err := os.Stat(configFile)
+	if err != nil {
+		// Reading the file reports the problem
+		return nil
+	}
+
+	mode := info.Mode().Perm()
+	if mode&0o077 == 0 {
+		return nil
+	}
+
+	if insecure {
+		logger.Warn("config file is accessible by other users", "file", configFile, "mode", fmt.Sprintf("%04o", mode))
+		return nil
+	}
+	return fmt.Errorf("config file %s is accessible by other users (mode %04o) but holds the client secret: run chmod 600 %s, or pass --insecure-config", configFile, mode, configFile)
+}
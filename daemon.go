@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// splitDaemonArgs takes --cron out of the daemon's arguments; the rest are
+// the flags of the meetings to create.
+func splitDaemonArgs(args []string) (schedule string, rest []string, err error) {
+	for i := 0; i < len(args); i++ {
+		name, value, hasValue := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") || name != "cron" {
+			rest = append(rest, args[i])
+			continue
+		}
+		if !hasValue {
+			if i+1 == len(args) {
+				return "", nil, errors.New("flag needs an argument: --cron")
+			}
+			i++
+			value = args[i]
+		}
+		schedule = value
+	}
+
+	if schedule == "" {
+		return "", nil, errors.New(`--cron is required, e.g. --cron "0 9 * * 1-5" for 9am on weekdays`)
+	}
+	return schedule, rest, nil
+}
+
+// runDaemon creates a meeting with the given flags each time the --cron
+// schedule fires, until interrupted. Each meeting is created by a run of
+// zoom-meeting create, so that a failure ends that run and is logged
+// while the daemon carries on.
+func runDaemon(ctx context.Context, args []string) {
+	schedule, rest, err := splitDaemonArgs(args)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+
+	// Check the meeting flags now rather than at the first tick
+	opts, err := parseFlags(rest)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if flag := interactiveFlag(opts); flag != "" {
+		fatalf(exitConfig, "Error parsing flags: %s cannot be used with daemon, which runs with nobody at the screen", flag)
+	}
+	opts.apply()
+
+	// Nor to copy or open the link
+	rest = append(rest, "--no-copy", "--no-open")
+
+	exe, err := os.Executable()
+	if err != nil {
+		fatalf(exitLocal, "Error finding the zoom-meeting executable: %v", err)
+	}
+
+	// A meeting still being created, e.g. slowed by retries, skips the
+	// next tick rather than running alongside it
+	c := cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger)))
+	var id cron.EntryID
+	id, err = c.AddFunc(schedule, func() {
+		logger.Info("Creating the scheduled meeting")
+		cmd := exec.CommandContext(ctx, exe, append([]string{"create"}, rest...)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil && ctx.Err() == nil {
+			logger.Warn("creating the scheduled meeting failed, trying again at the next time", "error", err)
+		}
+		logger.Info("Next meeting", "at", c.Entry(id).Next.Format(time.RFC3339))
+	})
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: invalid --cron %q: %v", schedule, err)
+	}
+
+	c.Start()
+	logger.Info(fmt.Sprintf("Creating meetings on the schedule %q until interrupted", schedule), "next", c.Entry(id).Next.Format(time.RFC3339))
+
+	<-ctx.Done()
+	<-c.Stop().Done()
+	logger.Info("Stopped")
+}
+
+// interactiveFlag returns the first of the flags in opts that need someone
+// at the screen, which a scheduled run cannot have, or "" if none is set.
+func interactiveFlag(opts cliOptions) string {
+	switch {
+	case opts.Wait:
+		return "--wait"
+	case opts.Edit:
+		return "--edit"
+	case opts.Stdin:
+		return "--stdin"
+	}
+	return ""
+}
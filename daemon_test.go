@@ -0,0 +1,24 @@
+package main
+
+import "testing"
+
+func TestDaemonRejectsInteractiveFlags(t *testing.T) {
+	for _, flag := range []string{"--wait", "--edit", "--stdin"} {
+		opts, err := parseFlags([]string{flag})
+		if err != nil {
+			t.Errorf("parseFlags(%s): %v", flag, err)
+			continue
+		}
+		if got := interactiveFlag(opts); got != flag {
+			t.Errorf("interactiveFlag with %s = %q, want %q", flag, got, flag)
+		}
+	}
+
+	opts, err := parseFlags([]string{"--topic", "Standup", "--duration", "15"})
+	if err != nil {
+		t.Fatalf("parseFlags: %v", err)
+	}
+	if got := interactiveFlag(opts); got != "" {
+		t.Errorf("interactiveFlag = %q, want none", got)
+	}
+}
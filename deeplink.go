@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// zoomAppLink rewrites a join URL such as
+// https://us02web.zoom.us/j/81234567890?pwd=abc into the zoommtg:// deep
+// link that opens the meeting in the Zoom desktop client.
+func zoomAppLink(joinURL string) (string, error) {
+	u, err := url.Parse(joinURL)
+	if err != nil {
+		return "", err
+	}
+
+	dir, id := path.Split(strings.TrimSuffix(u.Path, "/"))
+	if dir != "/j/" || id == "" {
+		return "", errors.New("join URL has no /j/<meeting-id> path")
+	}
+	if _, err := normalizeMeetingID(id); err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("action", "join")
+	query.Set("confno", id)
+	if pwd := u.Query().Get("pwd"); pwd != "" {
+		query.Set("pwd", pwd)
+	}
+
+	return "zoommtg://zoom.us/join?" + query.Encode(), nil
+}
+
+// meetingOpenURL returns what to open for --open-with: the join URL itself
+// for the browser, or the desktop client deep link, falling back to the
+// join URL when it cannot be parsed.
+func meetingOpenURL(joinURL, openWith string) string {
+	if openWith != "zoom-app" {
+		return joinURL
+	}
+
+	link, err := zoomAppLink(joinURL)
+	if err != nil {
+		logger.Warn("cannot open the meeting in the Zoom app, opening it in the browser", "join_url", joinURL, "error", err)
+		return joinURL
+	}
+	return link
+}
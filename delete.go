@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// normalizeMeetingID strips the spaces Zoom uses when displaying meeting
+// IDs ("123 4567 8901") and checks that what is left is numeric.
+func normalizeMeetingID(id string) (string, error) {
+	id = strings.ReplaceAll(id, " ", "")
+	if id == "" {
+		return "", errors.New("meeting ID is empty")
+	}
+	for _, r := range id {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("invalid meeting ID %q: must be numeric", id)
+		}
+	}
+	return id, nil
+}
+
+// meetingFilter selects meetings for a bulk delete. A zero field matches
+// every meeting.
+type meetingFilter struct {
+	before        time.Time
+	topicContains string
+}
+
+// parseBefore reads --before: a date such as "2025-01-01", which means its
+// midnight in the local timezone, or any time --start accepts.
+func parseBefore(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(value), time.Local); err == nil {
+		return t, nil
+	}
+	t, err := parseTime(value, time.Now())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --before: %w", err)
+	}
+	return t, nil
+}
+
+// matches reports whether m passes the filter. Meetings with no start time,
+// such as recurring ones with no fixed time, never start before anything.
+func (f meetingFilter) matches(m zoom.Meeting) bool {
+	if f.topicContains != "" && !strings.Contains(strings.ToLower(m.Topic), strings.ToLower(f.topicContains)) {
+		return false
+	}
+	if !f.before.IsZero() {
+		start, err := time.Parse(time.RFC3339, m.StartTime)
+		if err != nil || !start.Before(f.before) {
+			return false
+		}
+	}
+	return true
+}
+
+func runDelete(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting delete", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	yes := fs.Bool("yes", false, "delete without asking for confirmation")
+	before := fs.String("before", "", `delete every meeting starting before this date or time, e.g. "2025-01-01"`)
+	topicContains := fs.String("topic-contains", "", "delete every meeting whose topic contains this text, ignoring case")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting delete [flags] <meeting-id>\n       zoom-meeting delete [flags] [--before <date>] [--topic-contains <text>]\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+
+	positional := parseArgs(fs, args)
+	bulk := *before != "" || *topicContains != ""
+	if bulk && len(positional) > 0 {
+		fatalf(exitConfig, "Error parsing arguments: give a meeting ID or --before and --topic-contains filters, not both")
+	}
+	if !bulk && len(positional) != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	if bulk {
+		filter := meetingFilter{topicContains: *topicContains}
+		if *before != "" {
+			filter.before, err = parseBefore(*before)
+			if err != nil {
+				fatalf(exitConfig, "Error parsing flags: %v", err)
+			}
+		}
+		deleteMatching(ctx, opts, filter, *yes)
+		return
+	}
+
+	id, err := normalizeMeetingID(positional[0])
+	if err != nil {
+		fatalf(exitConfig, "Error parsing arguments: %v", err)
+	}
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+
+	if !*yes {
+		meeting, err := client.GetMeeting(ctx, id)
+		if err != nil {
+			exitIfCancelled(ctx)
+			fatalf(exitCodeFor(err), "Error looking up meeting: %v", err)
+		}
+
+		ok := confirm(ctx, fmt.Sprintf("Delete meeting %s?", meeting.Topic))
+		exitIfCancelled(ctx)
+		if !ok {
+			fmt.Println("Aborted")
+			return
+		}
+	}
+
+	if err := client.DeleteMeeting(ctx, id); err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error deleting meeting: %v", err)
+	}
+
+	fmt.Printf("Deleted meeting %s\n", id)
+}
+
+// deleteMatching deletes every meeting of the user passing filter, after
+// listing them and, without --yes, asking once for all of them. A failure
+// does not stop the rest.
+func deleteMatching(ctx context.Context, opts globalOptions, filter meetingFilter, yes bool) {
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+	meetings, err := client.ListMeetings(ctx)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error listing meetings: %v", err)
+	}
+
+	var matching []zoom.Meeting
+	for _, m := range meetings {
+		if filter.matches(m) {
+			matching = append(matching, m)
+		}
+	}
+	if len(matching) == 0 {
+		fmt.Println("No meetings match")
+		return
+	}
+
+	out := outputWriter{w: os.Stdout, format: formatTable}
+	if err := out.write(listResult(matching)); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+	if !yes {
+		ok := confirm(ctx, fmt.Sprintf("Delete these %d meetings?", len(matching)))
+		exitIfCancelled(ctx)
+		if !ok {
+			fmt.Println("Aborted")
+			return
+		}
+	}
+
+	var errs []error
+	for i, m := range matching {
+		id := strconv.FormatInt(m.ID, 10)
+		if err := client.DeleteMeeting(ctx, id); err != nil {
+			exitIfCancelled(ctx)
+			errs = append(errs, fmt.Errorf("%s (%s): %w", id, m.Topic, err))
+			continue
+		}
+		fmt.Printf("[%d/%d] Deleted meeting %s %s\n", i+1, len(matching), id, m.Topic)
+	}
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		fatalf(exitCodeFor(err), "Error deleting %d of %d meetings:\n%v", len(errs), len(matching), err)
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// maxAgendaLength is the longest agenda Zoom accepts, in characters.
+const maxAgendaLength = 2000
+
+// Meeting durations Zoom accepts, in minutes, and the longest group meeting
+// on the free plan.
+const (
+	minDuration   = 1
+	maxDuration   = 1440
+	freePlanLimit = 40
+)
+
+func defaultMeetingDetails() zoom.MeetingDetails {
+	return zoom.MeetingDetails{
+		Topic:    defaultTopic,
+		Type:     defaultType,
+		Duration: defaultDuration,
+	}
+}
+
+// buildMeetingDetails applies the explicitly set flags on top of base,
+// which holds the defaults or a loaded template, and resolves the start
+// time, timezone and recurrence into what Zoom expects for the type. With
+// --edit the flags were applied before editing, so base is used as is.
+func buildMeetingDetails(opts cliOptions, base zoom.MeetingDetails) (zoom.MeetingDetails, error) {
+	details := base
+	if !opts.Edit {
+		var err error
+		details, err = applyDetailFlags(opts, base)
+		if err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+	}
+
+	if err := validateMeetingType(details.Type); err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+	// A personal room meeting is scheduled like any other, with use_pmi;
+	// Zoom does not offer registration for it
+	if details.Type == zoom.TypePMI {
+		details = details.WithPMI()
+		if requiresRegistration(details.Settings) {
+			return zoom.MeetingDetails{}, errors.New("registration is not available for meetings in the personal meeting room")
+		}
+	}
+
+	details.Agenda = truncateAgenda(details.Agenda)
+
+	if details.PreSchedule && details.Type != zoom.TypeScheduled {
+		return zoom.MeetingDetails{}, fmt.Errorf("only scheduled meetings (type 2) can be pre-scheduled, not type %d", details.Type)
+	}
+
+	// Zoom only offers registration for scheduled and recurring meetings
+	// with a fixed time
+	if requiresRegistration(details.Settings) && details.Type != zoom.TypeScheduled && details.Type != zoom.TypeRecurringFixedTime {
+		return zoom.MeetingDetails{}, fmt.Errorf("registration is not available for meetings of type %d: use type 2 or 8", details.Type)
+	}
+
+	timezone, loc, err := resolveTimezone(details.Timezone)
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+
+	// Instant meetings start right away and recurring meetings with no
+	// fixed time whenever someone joins, so start_time, duration and
+	// timezone are left empty and dropped from the payload by omitempty
+	if details.Type == zoom.TypeInstant || details.Type == zoom.TypeRecurringNoFixed {
+		if opts.isSet("start") || opts.isSet("duration") || opts.isSet("timezone") || opts.Until != "" {
+			logger.Warn("this meeting type has no start time, duration or timezone: ignoring them", "type", details.Type)
+		}
+		details.Start = ""
+		details.Duration = 0
+		details.Timezone = ""
+	} else {
+		details.Timezone = timezone
+
+		if details.PreSchedule {
+			// The start time is set later, when the meeting is updated
+			details.Start = ""
+		} else {
+			// Resolve the start time in ISO 8601 format
+			startTime, err := parseStartTime(details.Start, loc)
+			if err != nil {
+				return zoom.MeetingDetails{}, err
+			}
+
+			details.Start = formatStartTime(startTime, timezone, loc)
+
+			if opts.Until != "" {
+				duration, err := durationUntil(startTime, opts.Until)
+				if err != nil {
+					return zoom.MeetingDetails{}, err
+				}
+				details.Duration = duration
+			}
+		}
+
+		if err := validateDuration(details.Duration); err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+		if opts.Plan == "free" && details.Duration > freePlanLimit {
+			logger.Warn("meetings on the free plan end after 40 minutes when three or more people join", "duration", details.Duration)
+		}
+	}
+
+	// Only recurring meetings with a fixed time carry a recurrence
+	if details.Type == zoom.TypeRecurringFixedTime {
+		if opts.Recur != "" {
+			recurrence, err := buildRecurrence(opts, loc)
+			if err != nil {
+				return zoom.MeetingDetails{}, err
+			}
+			details.Recurrence = recurrence
+		}
+		if details.Recurrence == nil {
+			return zoom.MeetingDetails{}, errors.New("recurring meetings with a fixed time (type 8) need a recurrence pattern: set --recur")
+		}
+	} else {
+		details.Recurrence = nil
+	}
+
+	return details, nil
+}
+
+// applyDetailFlags returns details with the explicitly set meeting and
+// settings flags applied, as given and not yet resolved.
+func applyDetailFlags(opts cliOptions, details zoom.MeetingDetails) (zoom.MeetingDetails, error) {
+	if opts.isSet("topic") {
+		details.Topic = opts.Topic
+	}
+	if opts.isSet("type") {
+		details.Type = opts.Type
+	}
+	if opts.isSet("duration") {
+		details.Duration = opts.Duration
+	}
+	if opts.isSet("password") {
+		details.Password = opts.Password
+	}
+	if opts.isSet("agenda") {
+		details.Agenda = opts.Agenda
+	}
+	if opts.GeneratePassword > 0 {
+		passcode, err := generatePasscode(opts.GeneratePassword)
+		if err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+		details.Password = passcode
+	}
+	if opts.isSet("timezone") {
+		details.Timezone = opts.Timezone
+	}
+	if opts.isSet("start") {
+		details.Start = opts.Start
+	}
+	if opts.TemplateID != "" {
+		details.TemplateID = opts.TemplateID
+	}
+	if opts.PreSchedule {
+		details.PreSchedule = true
+	}
+
+	details.Settings = applySettingsFlags(opts, details.Settings)
+
+	if opts.Breakout != "" {
+		rooms, err := readBreakoutRooms(opts.Breakout)
+		if err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+		if details.Settings == nil {
+			details.Settings = &zoom.MeetingSettings{}
+		}
+		details.Settings.BreakoutRoom = &zoom.BreakoutRooms{Enable: true, Rooms: rooms}
+	}
+
+	return details, nil
+}
+
+func validateDuration(duration int) error {
+	if duration < minDuration || duration > maxDuration {
+		return fmt.Errorf("invalid duration %d minutes: must be between %d and %d", duration, minDuration, maxDuration)
+	}
+	return nil
+}
+
+// durationUntil returns the minutes from start to the end time given by
+// --until. A bare clock time such as "15:30" falls on the start's day.
+func durationUntil(start time.Time, until string) (int, error) {
+	end, err := parseTime(until, start)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --until: %w", err)
+	}
+
+	minutes := int(end.Sub(start).Round(time.Minute).Minutes())
+	if minutes <= 0 {
+		return 0, fmt.Errorf("--until %s is not after the start time %s", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	return minutes, nil
+}
+
+func truncateAgenda(agenda string) string {
+	runes := []rune(agenda)
+	if len(runes) <= maxAgendaLength {
+		return agenda
+	}
+
+	logger.Warn("agenda exceeds Zoom's limit, truncating", "length", len(runes), "limit", maxAgendaLength)
+	return string(runes[:maxAgendaLength])
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// printDryRun shows the request the zoom.Client would send, without
+// fetching an OAuth token or contacting Zoom.
+func printDryRun(method, url string, payload interface{}) error {
+	body, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s %s\n", method, url)
+	fmt.Println("Authorization: Bearer <hidden>")
+	fmt.Println("Content-Type: application/json")
+	fmt.Println()
+	fmt.Println(string(body))
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+	"gopkg.in/yaml.v3"
+)
+
+// editHeader starts the buffer --edit opens, the way git commit explains
+// its message buffer.
+const editHeader = `# Edit the meeting details and save to create the meeting. The fields are
+# those of a --template: topic, type, start_time, duration, timezone,
+# password, agenda, template_id, recurrence and settings.
+#
+# Lines starting with "#" are ignored. Leaving the details unchanged or
+# empty aborts creating the meeting.
+`
+
+// editorCommand returns the user's editor from $VISUAL or $EDITOR, which
+// may carry arguments such as "code --wait".
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// editMeetingDetails opens details as YAML in the user's editor and
+// returns what was saved. Fields the user deletes are left out.
+func editMeetingDetails(ctx context.Context, details zoom.MeetingDetails) (zoom.MeetingDetails, error) {
+	buffer, err := detailsYAML(details)
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+	buffer = append([]byte(editHeader+"\n"), buffer...)
+
+	file, err := os.CreateTemp("", "zoom-meeting-*.yaml")
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(buffer); err != nil {
+		file.Close()
+		return zoom.MeetingDetails{}, err
+	}
+	if err := file.Close(); err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+
+	editor := editorCommand()
+	cmd := exec.CommandContext(ctx, editor[0], append(editor[1:], file.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("editor %s failed, aborting: %w", editor[0], err)
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+	if bytes.Equal(edited, buffer) {
+		return zoom.MeetingDetails{}, errors.New("meeting details left unchanged, aborting")
+	}
+	if isBlankYAML(edited) {
+		return zoom.MeetingDetails{}, errors.New("meeting details left empty, aborting")
+	}
+
+	data, err := yamlToJSON(edited)
+	if err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("parsing the edited details: %w", err)
+	}
+	var result zoom.MeetingDetails
+	if err := json.Unmarshal(data, &result); err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("parsing the edited details: %w", err)
+	}
+
+	return result, nil
+}
+
+// detailsYAML renders details as YAML with the API field names, going
+// through JSON so that the json tags name the fields.
+func detailsYAML(details zoom.MeetingDetails) ([]byte, error) {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(document)
+}
+
+// isBlankYAML reports whether data holds nothing but comments and blank
+// lines.
+func isBlankYAML(data []byte) bool {
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
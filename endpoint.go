@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// baseURLFromEnv returns ZOOM_BASE_URL, the default for --base-url.
+func baseURLFromEnv() string {
+	return os.Getenv("ZOOM_BASE_URL")
+}
+
+// parseProxyURL checks a --proxy URL. Like the proxy environment variables
+// it may leave out the scheme, which then defaults to http.
+func parseProxyURL(proxy string) (*url.URL, error) {
+	if !strings.Contains(proxy, "://") {
+		proxy = "http://" + proxy
+	}
+	// url.Error would repeat the URL, and with it any password
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", errors.Unwrap(err))
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: the scheme must be http, https or socks5", u.Redacted())
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: it has no host", u.Redacted())
+	}
+	return u, nil
+}
+
+// zoomEndpoints derives the REST API root and OAuth token endpoint from a
+// --base-url such as https://api.zoomgov.com. The API lives under /v2 on
+// that host and OAuth on the same domain without the "api." prefix, as on
+// the commercial cloud (api.zoom.us and zoom.us).
+func zoomEndpoints(baseURL string) (apiURL, authURL string, err error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return "", "", fmt.Errorf("invalid base URL %q: must be an https URL such as https://api.zoomgov.com", baseURL)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", "", fmt.Errorf("invalid base URL %q: give only the scheme and host, e.g. https://api.zoomgov.com", baseURL)
+	}
+
+	authHost := strings.TrimPrefix(u.Host, "api.")
+	return "https://" + u.Host + "/v2", "https://" + authHost + "/oauth/token", nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"os"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// Exit codes, so that scripts can tell why a run failed. Anything not
+// covered here exits with 1.
+const (
+	exitConfig  = 2 // bad flags, arguments, templates or config file
+	exitAuth    = 3 // Zoom rejected the credentials
+	exitAPI     = 4 // Zoom answered a request with an error
+	exitNetwork = 5 // Zoom could not be reached or timed out
+	exitLocal   = 6 // a local file or the output could not be written, or a step after creating failed
+)
+
+// exitCodeFor classifies an error returned by a Zoom request.
+func exitCodeFor(err error) int {
+	var urlErr *url.Error
+	var netErr net.Error
+	var configErr *zoom.ConfigError
+
+	switch {
+	case errors.As(err, &configErr):
+		return exitConfig
+	case zoom.IsAuthError(err):
+		return exitAuth
+	case zoom.IsAPIError(err):
+		return exitAPI
+	case errors.As(err, &urlErr), errors.As(err, &netErr):
+		return exitNetwork
+	}
+	return 1
+}
+
+// fatalf is log.Fatalf with a chosen exit code. Credentials that made it
+// into the message are masked.
+func fatalf(code int, format string, v ...any) {
+	log.Print(zoom.Redact(fmt.Sprintf(format, v...)))
+	os.Exit(code)
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseFlagsRejectsIgnoredScheduleFlags(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--type", "1", "--until", "15:30"}, "--until conflicts with --type 1"},
+		{[]string{"--type", "3", "--until", "15:30"}, "--until conflicts with --type 3"},
+		{[]string{"--recur-count", "5"}, "--recur-count needs --recur"},
+		{[]string{"--type", "8", "--recur-until", "2030-12-31"}, "--recur-until needs --recur"},
+		{[]string{"--instant", "--recur-days", "2"}, "--recur-days needs --recur"},
+		{[]string{"--recur-interval", "2"}, "--recur-interval needs --recur"},
+	}
+	for _, tt := range tests {
+		_, err := parseFlags(tt.args)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("parseFlags(%q) error = %v, want %q", tt.args, err, tt.want)
+		}
+	}
+}
+
+func TestParseFlagsAcceptsScheduleFlags(t *testing.T) {
+	for _, args := range [][]string{
+		{"--until", "15:30"},
+		{"--type", "2", "--until", "15:30"},
+		{"--recur", "weekly", "--recur-days", "2,4", "--recur-count", "10", "--until", "15:30"},
+	} {
+		if _, err := parseFlags(args); err != nil {
+			t.Errorf("parseFlags(%q): %v", args, err)
+		}
+	}
+}
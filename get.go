@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// meetingResult is the output of get.
+type meetingResult struct {
+	meeting      *zoom.Meeting
+	showStartURL bool
+}
+
+func (r meetingResult) jsonValue() interface{} {
+	return r.meeting
+}
+
+func (r meetingResult) table() ([]string, [][]string) {
+	m := r.meeting
+	return []string{"ID", "TOPIC", "START", "DURATION", "PASSCODE", "JOIN URL"},
+		[][]string{{strconv.FormatInt(m.ID, 10), m.Topic, m.StartTime, strconv.Itoa(m.Duration), m.Password, m.JoinURL}}
+}
+
+func (r meetingResult) writePlain(w io.Writer) {
+	m := r.meeting
+	fmt.Fprintln(w, "Topic:", m.Topic)
+	fmt.Fprintln(w, "Meeting ID:", m.ID)
+	if when := invitationTime(m); when != "" {
+		fmt.Fprintln(w, "Start:", when)
+	}
+	if m.Duration != 0 {
+		fmt.Fprintln(w, "Duration:", m.Duration, "minutes")
+	}
+	if m.JoinURL != "" {
+		fmt.Fprintln(w, "Meeting link:", paint(w, linkColor, m.JoinURL))
+	}
+	if m.Password != "" {
+		fmt.Fprintln(w, "Passcode:", paint(w, passcodeColor, m.Password))
+	}
+	// As when creating, the start URL is only shown on request
+	if r.showStartURL {
+		fmt.Fprintln(w, "Start URL:", paint(w, linkColor, m.StartURL))
+	}
+	if m.RegistrationURL != "" {
+		fmt.Fprintln(w, "Registration link:", paint(w, linkColor, m.RegistrationURL))
+	}
+	if m.Agenda != "" {
+		fmt.Fprintln(w, "Agenda:", m.Agenda)
+	}
+
+	if settings := describeSettings(m.Settings); len(settings) > 0 {
+		fmt.Fprintln(w, "Settings:")
+		for _, s := range settings {
+			fmt.Fprintln(w, "  "+s)
+		}
+	}
+}
+
+// describeSettings lists the meeting settings the flags can change, as
+// "name: value" lines.
+func describeSettings(s *zoom.MeetingSettings) []string {
+	if s == nil {
+		return nil
+	}
+
+	var lines []string
+	onOff := func(name string, value *bool) {
+		if value == nil {
+			return
+		}
+		state := "off"
+		if *value {
+			state = "on"
+		}
+		lines = append(lines, name+": "+state)
+	}
+
+	onOff("Host video", s.HostVideo)
+	onOff("Join before host", s.JoinBeforeHost)
+	if s.JBHTime != nil && s.JoinBeforeHost != nil && *s.JoinBeforeHost {
+		if *s.JBHTime == 0 {
+			lines = append(lines, "Join before host window: any time")
+		} else {
+			lines = append(lines, fmt.Sprintf("Join before host window: %d minutes", *s.JBHTime))
+		}
+	}
+	onOff("Mute upon entry", s.MuteUponEntry)
+	onOff("Waiting room", s.WaitingRoom)
+	if s.AlternativeHosts != "" {
+		lines = append(lines, "Alternative hosts: "+strings.ReplaceAll(s.AlternativeHosts, ";", ", "))
+	}
+	if s.AutoRecording != "" {
+		lines = append(lines, "Recording: "+s.AutoRecording)
+	}
+	switch s.Audio {
+	case zoom.AudioVoIP:
+		lines = append(lines, "Audio: computer audio only")
+	case zoom.AudioTelephony:
+		lines = append(lines, "Audio: telephone only")
+	case zoom.AudioBoth:
+		lines = append(lines, "Audio: computer audio and telephone")
+	case zoom.AudioThirdParty:
+		lines = append(lines, "Audio: third-party audio")
+	}
+	if s.ApprovalType != nil {
+		switch *s.ApprovalType {
+		case zoom.ApprovalAutomatic:
+			lines = append(lines, "Registration: approved automatically")
+		case zoom.ApprovalManual:
+			lines = append(lines, "Registration: approved manually")
+		}
+	}
+	if s.ContactEmail != "" {
+		lines = append(lines, "Contact: "+strings.TrimSpace(s.ContactName+" <"+s.ContactEmail+">"))
+	}
+	return lines
+}
+
+func runGet(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting get", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting get [flags] <meeting-id>\n\nPrints a meeting's details. Flags:\n")
+		fs.PrintDefaults()
+	}
+	var format string
+	addFormatFlag(fs, &format, formatPlain)
+	jsonOutput := fs.Bool("json", false, "shorthand for --format json")
+	showStartURL := fs.Bool("show-start-url", false, "also print the start URL, which lets anyone start the meeting as host")
+	copyField := fs.String("copy", "", "also copy this to the clipboard: join_url, start_url or id")
+
+	positional := parseArgs(fs, args)
+	if len(positional) != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if *jsonOutput {
+		if format != formatPlain && format != formatJSON {
+			fatalf(exitConfig, "Error parsing flags: --json conflicts with --format %s", format)
+		}
+		format = formatJSON
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	switch *copyField {
+	case "", "join_url", "start_url", "id":
+	default:
+		fatalf(exitConfig, "Error parsing flags: invalid --copy value %q: must be join_url, start_url or id", *copyField)
+	}
+	opts.apply()
+
+	id, err := normalizeMeetingID(positional[0])
+	if err != nil {
+		fatalf(exitConfig, "Error parsing arguments: %v", err)
+	}
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	meeting, err := opts.newClient(config).GetMeeting(ctx, id)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error getting meeting: %v", err)
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(meetingResult{meeting: meeting, showStartURL: *showStartURL}); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+
+	if *copyField != "" {
+		if err := copyToClipboard(clipboardText(meeting, *copyField)); err != nil {
+			logger.Warn("could not copy to the clipboard", "error", err)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// historyEntry is one line of the history file.
+type historyEntry struct {
+	ID        int64     `json:"id"`
+	Topic     string    `json:"topic"`
+	StartTime string    `json:"start_time,omitempty"`
+	JoinURL   string    `json:"join_url"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// defaultHistoryEntries is how many entries the history subcommand shows
+// without -n.
+const defaultHistoryEntries = 10
+
+// historyPath picks the history file from the --history-file flag, then
+// the ZOOM_MEETING_HISTORY environment variable, then
+// ~/.zoom-meeting.history.jsonl.
+func historyPath(flagPath string) (string, error) {
+	if flagPath != "" {
+		return flagPath, nil
+	}
+
+	if envPath := os.Getenv("ZOOM_MEETING_HISTORY"); envPath != "" {
+		return envPath, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("finding user home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".zoom-meeting.history.jsonl"), nil
+}
+
+// appendHistory adds the meeting to the history file as one JSON line.
+// The file is opened in append mode and locked while writing, so that
+// concurrent runs never interleave their lines.
+func appendHistory(path string, meeting *zoom.Meeting) error {
+	line, err := json.Marshal(historyEntry{
+		ID:        meeting.ID,
+		Topic:     meeting.Topic,
+		StartTime: meeting.StartTime,
+		JoinURL:   meeting.JoinURL,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening history file: %w", err)
+	}
+	defer file.Close()
+
+	if err := lockFile(file); err != nil {
+		return fmt.Errorf("locking history file: %w", err)
+	}
+	defer unlockFile(file)
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing history file: %w", err)
+	}
+
+	return nil
+}
+
+// recordHistory appends the meeting to the history file chosen by flagPath.
+func recordHistory(flagPath string, meeting *zoom.Meeting) error {
+	path, err := historyPath(flagPath)
+	if err != nil {
+		return err
+	}
+	return appendHistory(path, meeting)
+}
+
+// readHistory returns the last n entries of the history file, oldest
+// first. A missing file is an empty history; lines that are not valid
+// JSON are skipped with a warning. The file is locked while reading, so
+// that a prune in progress is never seen half written.
+func readHistory(path string, n int) ([]historyEntry, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening history file: %w", err)
+	}
+	defer file.Close()
+
+	if err := lockFile(file); err != nil {
+		return nil, fmt.Errorf("locking history file: %w", err)
+	}
+	defer unlockFile(file)
+
+	var entries []historyEntry
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry historyEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			logger.Warn("skipping invalid history line", "file", path, "line", lineNumber)
+			continue
+		}
+
+		entries = append(entries, entry)
+		if len(entries) > n {
+			entries = entries[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading history file: %w", err)
+	}
+
+	return entries, nil
+}
+
+// pruneHistory trims the history file to its last keep lines and returns
+// how many it removed; keep 0 empties it.
+func pruneHistory(path string, keep int) (int, error) {
+	return rewriteHistory(path, func(lines [][]byte) [][]byte {
+		if len(lines) <= keep {
+			return lines
+		}
+		return lines[len(lines)-keep:]
+	})
+}
+
+// forgetHistory removes the entries of the meeting with the given ID from
+// the history file, once the meeting is deleted, and returns how many it
+// removed. Lines that are not valid JSON are kept.
+func forgetHistory(path string, id int64) (int, error) {
+	return rewriteHistory(path, func(lines [][]byte) [][]byte {
+		var kept [][]byte
+		for _, line := range lines {
+			var entry historyEntry
+			if json.Unmarshal(line, &entry) == nil && entry.ID == id {
+				continue
+			}
+			kept = append(kept, line)
+		}
+		return kept
+	})
+}
+
+// rewriteHistory replaces the lines of the history file with those filter
+// keeps of them, and returns how many it dropped. A missing file is left
+// missing. The file is rewritten in place rather than replaced, so that
+// appends waiting on the lock still write to the file everyone else reads.
+func rewriteHistory(path string, filter func(lines [][]byte) [][]byte) (int, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("opening history file: %w", err)
+	}
+	defer file.Close()
+
+	if err := lockFile(file); err != nil {
+		return 0, fmt.Errorf("locking history file: %w", err)
+	}
+	defer unlockFile(file)
+
+	var lines [][]byte
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading history file: %w", err)
+	}
+
+	keptLines := filter(lines)
+	removed := len(lines) - len(keptLines)
+	if removed == 0 {
+		return 0, nil
+	}
+
+	var kept []byte
+	for _, line := range keptLines {
+		kept = append(append(kept, line...), '\n')
+	}
+
+	if err := file.Truncate(0); err != nil {
+		return 0, fmt.Errorf("writing history file: %w", err)
+	}
+	if _, err := file.WriteAt(kept, 0); err != nil {
+		return 0, fmt.Errorf("writing history file: %w", err)
+	}
+
+	return removed, nil
+}
+
+// historyResult is the output of history.
+type historyResult []historyEntry
+
+func (r historyResult) jsonValue() interface{} {
+	if r == nil {
+		return []historyEntry{}
+	}
+	return []historyEntry(r)
+}
+
+func (r historyResult) table() ([]string, [][]string) {
+	header := []string{"CREATED", "ID", "TOPIC", "START", "JOIN URL"}
+	rows := make([][]string, len(r))
+	for i, e := range r {
+		rows[i] = []string{e.CreatedAt.Local().Format("2006-01-02 15:04"), strconv.FormatInt(e.ID, 10), e.Topic, e.StartTime, e.JoinURL}
+	}
+	return header, rows
+}
+
+// writePlain prints one entry per line with the topic last, as list does.
+func (r historyResult) writePlain(w io.Writer) {
+	for _, e := range r {
+		fmt.Fprintf(w, "%s %d %s %s %s\n", e.CreatedAt.Format(time.RFC3339), e.ID, e.StartTime, e.JoinURL, e.Topic)
+	}
+}
+
+func runHistory(ctx context.Context, args []string) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "prune":
+			runHistoryPrune(ctx, args[1:])
+			return
+		case "clear":
+			runHistoryClear(ctx, args[1:])
+			return
+		}
+	}
+
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting history", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	count := fs.Int("n", defaultHistoryEntries, "number of most recent meetings to show")
+	var format string
+	addFormatFlag(fs, &format, formatTable)
+	historyFile := fs.String("history-file", "", "path to the history file, overrides ZOOM_MEETING_HISTORY (default ~/.zoom-meeting.history.jsonl)")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if *count < 1 {
+		fatalf(exitConfig, "Error parsing flags: invalid -n %d: must be at least 1", *count)
+	}
+	opts.apply()
+
+	path, err := historyPath(*historyFile)
+	if err != nil {
+		fatalf(exitLocal, "Error reading history: %v", err)
+	}
+
+	entries, err := readHistory(path, *count)
+	if err != nil {
+		fatalf(exitLocal, "Error reading history: %v", err)
+	}
+
+	if len(entries) == 0 && format != formatJSON {
+		fmt.Println("No meetings in history")
+		return
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(historyResult(entries)); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+}
+
+// runHistoryPrune keeps the most recent --keep entries of the history file.
+func runHistoryPrune(_ context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting history prune", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	keep := fs.Int("keep", 100, "number of most recent meetings to keep")
+	historyFile := fs.String("history-file", "", "path to the history file, overrides ZOOM_MEETING_HISTORY (default ~/.zoom-meeting.history.jsonl)")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if *keep < 0 {
+		fatalf(exitConfig, "Error parsing flags: invalid --keep %d: must not be negative", *keep)
+	}
+	opts.apply()
+
+	path, err := historyPath(*historyFile)
+	if err != nil {
+		fatalf(exitLocal, "Error pruning history: %v", err)
+	}
+
+	removed, err := pruneHistory(path, *keep)
+	if err != nil {
+		fatalf(exitLocal, "Error pruning history: %v", err)
+	}
+
+	logger.Info("Pruned the history", "file", path, "removed", removed, "kept", *keep)
+}
+
+// runHistoryClear empties the history file.
+func runHistoryClear(_ context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting history clear", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	historyFile := fs.String("history-file", "", "path to the history file, overrides ZOOM_MEETING_HISTORY (default ~/.zoom-meeting.history.jsonl)")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	path, err := historyPath(*historyFile)
+	if err != nil {
+		fatalf(exitLocal, "Error clearing history: %v", err)
+	}
+
+	removed, err := pruneHistory(path, 0)
+	if err != nil {
+		fatalf(exitLocal, "Error clearing history: %v", err)
+	}
+
+	logger.Info("Cleared the history", "file", path, "removed", removed)
+}
@@ -0,0 +1,81 @@
+//go:build unix
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+func TestConcurrentHistoryAppends(t *testing.T) {
+	const writers, perWriter = 20, 25
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	// Long topics make lines bigger than a single write is guaranteed to
+	// keep together, so an unlocked append would show up as a broken line
+	topic := strings.Repeat("x", 8192)
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		w := w
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWriter; i++ {
+				meeting := &zoom.Meeting{ID: int64(w*perWriter + i + 1), Topic: topic, JoinURL: "https://zoom.us/j/1"}
+				if err := appendHistory(path, meeting); err != nil {
+					t.Errorf("appendHistory: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	seen := map[int64]bool{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<20)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		var entry historyEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("line %d is not a history entry: %v", lineNumber, err)
+		}
+		if entry.Topic != topic {
+			t.Errorf("line %d: topic of %d bytes, want %d", lineNumber, len(entry.Topic), len(topic))
+		}
+		if seen[entry.ID] {
+			t.Errorf("line %d: meeting %d recorded twice", lineNumber, entry.ID)
+		}
+		seen[entry.ID] = true
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for id := int64(1); id <= writers*perWriter; id++ {
+		if !seen[id] {
+			t.Errorf("meeting %d is missing from the history", id)
+		}
+	}
+
+	entries, err := readHistory(path, writers*perWriter)
+	if err != nil {
+		t.Fatalf("readHistory: %v", err)
+	}
+	if len(entries) != writers*perWriter {
+		t.Errorf("readHistory returned %d entries, want %d", len(entries), writers*perWriter)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"text/template"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// hookFuncs are the functions available to --on-success beyond those of
+// text/template.
+var hookFuncs = template.FuncMap{"quote": shellQuote}
+
+// parseHookTemplate parses an --on-success command and runs it once
+// against an empty meeting, so that unknown fields are reported before any
+// request.
+func parseHookTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("on-success").Funcs(hookFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --on-success: %w", err)
+	}
+	if err := tmpl.Execute(io.Discard, &zoom.Meeting{}); err != nil {
+		return nil, fmt.Errorf("invalid --on-success: %w", err)
+	}
+	return tmpl, nil
+}
+
+// runHook renders the --on-success command for meeting and runs it with
+// the system shell. Its output goes to stderr, keeping stdout for the
+// result.
+func runHook(ctx context.Context, tmpl *template.Template, meeting *zoom.Meeting) error {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, meeting); err != nil {
+		return fmt.Errorf("rendering --on-success: %w", err)
+	}
+
+	shell := []string{"sh", "-c"}
+	if runtime.GOOS == "windows" {
+		shell = []string{"cmd", "/C"}
+	}
+
+	logger.Debug("running --on-success command", "command", b.String())
+	cmd := exec.CommandContext(ctx, shell[0], shell[1], b.String())
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// shellQuote quotes s as a single word for a POSIX shell, so that a topic
+// such as "Bob's sync" cannot break the command.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
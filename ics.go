@@ -0,0 +1,219 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// iCalendar (RFC 5545) date-time layouts, in local time and in UTC.
+const (
+	icsLocalTime = "20060102T150405"
+	icsUTCTime   = "20060102T150405Z"
+)
+
+// maxICSLineLength is the longest content line RFC 5545 allows, in octets,
+// before it must be folded.
+const maxICSLineLength = 75
+
+// writeICS writes the meetings as events of an iCalendar file at path, for
+// calendar apps to import. Recurring meetings get their first occurrence.
+func writeICS(path string, meetings []*zoom.Meeting) error {
+	var b strings.Builder
+	w := icsWriter{&b}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:-//optiowl//zoom-meeting//EN")
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+
+	// Each timezone is described once, covering all its events
+	written := map[string]bool{}
+	for _, meeting := range meetings {
+		start, loc, err := meetingStart(meeting)
+		if err != nil {
+			return err
+		}
+		if loc != time.UTC && !written[loc.String()] {
+			w.timezone(start.In(loc))
+			written[loc.String()] = true
+		}
+	}
+
+	stamp := time.Now().UTC().Format(icsUTCTime)
+	for _, meeting := range meetings {
+		start, loc, _ := meetingStart(meeting)
+		end := start.Add(time.Duration(meeting.Duration) * time.Minute)
+
+		w.line("BEGIN:VEVENT")
+		w.line(fmt.Sprintf("UID:%d@zoom-meeting", meeting.ID))
+		w.line("DTSTAMP:" + stamp)
+		if loc == time.UTC {
+			w.line("DTSTART:" + start.UTC().Format(icsUTCTime))
+			w.line("DTEND:" + end.UTC().Format(icsUTCTime))
+		} else {
+			w.line("DTSTART;TZID=" + loc.String() + ":" + start.In(loc).Format(icsLocalTime))
+			w.line("DTEND;TZID=" + loc.String() + ":" + end.In(loc).Format(icsLocalTime))
+		}
+		w.line("SUMMARY:" + icsEscape(meeting.Topic))
+		w.line("DESCRIPTION:" + icsEscape(icsDescription(meeting)))
+		if meeting.JoinURL != "" {
+			w.line("LOCATION:" + icsEscape(meeting.JoinURL))
+			w.line("URL:" + meeting.JoinURL)
+		}
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// The passcode and join link are in it, so it is the user's alone, as
+	// the config file is
+	if err := os.WriteFile(path, []byte(b.String()), 0o600); err != nil {
+		return err
+	}
+
+	// WriteFile keeps the mode of an existing file
+	return os.Chmod(path, 0o600)
+}
+
+// meetingStart returns when the meeting starts and the timezone to write
+// it in: its own, or UTC when it has none Go knows.
+func meetingStart(meeting *zoom.Meeting) (time.Time, *time.Location, error) {
+	if meeting.StartTime == "" {
+		return time.Time{}, nil, fmt.Errorf("meeting %d has no start time to put in a calendar", meeting.ID)
+	}
+	start, err := time.Parse(time.RFC3339, meeting.StartTime)
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("meeting %d: invalid start time %q", meeting.ID, meeting.StartTime)
+	}
+
+	loc := time.UTC
+	if meeting.Timezone != "" {
+		if l, err := time.LoadLocation(meeting.Timezone); err == nil {
+			loc = l
+		}
+	}
+	return start, loc, nil
+}
+
+// icsDescription is the event's body: how to join the meeting.
+func icsDescription(meeting *zoom.Meeting) string {
+	var lines []string
+	if meeting.JoinURL != "" {
+		lines = append(lines, "Join Zoom meeting: "+meeting.JoinURL)
+	}
+	lines = append(lines, fmt.Sprintf("Meeting ID: %d", meeting.ID))
+	if meeting.Password != "" {
+		lines = append(lines, "Passcode: "+meeting.Password)
+	}
+	if meeting.Agenda != "" {
+		lines = append(lines, "", meeting.Agenda)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// icsEscape escapes a TEXT value as RFC 5545 section 3.3.11 requires.
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// icsWriter writes content lines, folded and ended with CRLF.
+type icsWriter struct {
+	b *strings.Builder
+}
+
+// line writes one content line, folding it onto continuation lines that
+// start with a space so that none is longer than 75 octets. A UTF-8
+// character is never split.
+func (w icsWriter) line(s string) {
+	limit := maxICSLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(s[cut]) {
+			cut--
+		}
+		w.b.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// The leading space counts towards the next line's length
+		limit = maxICSLineLength - 1
+	}
+	w.b.WriteString(s + "\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// timezone writes a VTIMEZONE for t's location, with the offset in effect
+// at t and, when it changes, the one after, so that an event near a
+// daylight saving change is placed right.
+func (w icsWriter) timezone(t time.Time) {
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + t.Location().String())
+
+	start, end := t.ZoneBounds()
+	w.observance(start, t)
+	if !end.IsZero() {
+		w.observance(end, end.In(t.Location()))
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+// observance writes the period of the timezone in effect at t, beginning
+// at onset. A zero onset, for a zone that never changes, becomes 1970.
+func (w icsWriter) observance(onset, t time.Time) {
+	loc := t.Location()
+	name, offset := t.Zone()
+
+	// The offset before the onset is the one in effect just ahead of it
+	previous := offset
+	if !onset.IsZero() {
+		_, previous = onset.Add(-time.Second).In(loc).Zone()
+	} else {
+		onset = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).Add(-time.Duration(offset) * time.Second)
+	}
+
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	// DTSTART of an observance is in the local time before the change
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + onset.In(time.FixedZone("", previous)).Format(icsLocalTime))
+	w.line("TZOFFSETFROM:" + icsOffset(previous))
+	w.line("TZOFFSETTO:" + icsOffset(offset))
+	if name != "" && !strings.HasPrefix(name, "+") && !strings.HasPrefix(name, "-") {
+		w.line("TZNAME:" + name)
+	}
+	w.line("END:" + kind)
+}
+
+// icsOffset formats an offset in seconds east of UTC as "+0530".
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+}
+
+// validateICSMeeting rejects meeting types with no start time to put in a
+// calendar.
+func validateICSMeeting(details zoom.MeetingDetails) error {
+	if details.Type == zoom.TypeRecurringNoFixed || details.PreSchedule {
+		return errors.New("--ics needs a meeting with a start time, not a recurring meeting with no fixed time or a pre-scheduled one")
+	}
+	return nil
+}
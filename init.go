@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/optiowl/zoom-meeting/internal/atomicfile"
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// writeConfigFile saves a flat config holding config, readable only by the
+// user.
+func writeConfigFile(configFile string, config zoom.OAuthConfig) error {
+	fileContent, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configFile), 0o700); err != nil {
+		return err
+	}
+
+	// The client secret must never be readable by others, not even while
+	// an existing file is being replaced
+	return atomicfile.WriteFile(configFile, append(fileContent, '\n'))
+}
+
+func runInit(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting init", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting init [flags]\n\nAsks for the credentials of a Zoom Server-to-Server OAuth app and writes the config file. Flags:\n")
+		fs.PrintDefaults()
+	}
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	configFile, err := configPath(opts.Config)
+	if err != nil {
+		fatalf(exitConfig, "Error finding config file: %v", err)
+	}
+
+	if _, err := os.Stat(configFile); !errors.Is(err, os.ErrNotExist) {
+		ok := confirm(ctx, fmt.Sprintf("%s already exists. Overwrite it?", configFile))
+		exitIfCancelled(ctx)
+		if !ok {
+			fmt.Println("Aborted")
+			return
+		}
+	}
+
+	fmt.Println("Enter the credentials from your app's page on marketplace.zoom.us.")
+
+	var config zoom.OAuthConfig
+	for _, field := range []struct {
+		label  string
+		value  *string
+		secret bool
+	}{
+		{"Account ID", &config.AccountID, false},
+		{"Client ID", &config.ClientID, false},
+		{"Client secret", &config.ClientSecret, true},
+	} {
+		for *field.value == "" {
+			if field.secret {
+				*field.value, err = promptSecret(ctx, field.label)
+			} else {
+				*field.value, err = prompt(ctx, field.label)
+			}
+			exitIfCancelled(ctx)
+			if err != nil {
+				fatalf(exitConfig, "Error reading %s: %v", field.label, err)
+			}
+		}
+	}
+
+	if confirm(ctx, "Check the credentials with Zoom now?") {
+		// Skip the token cache so that the new credentials are really used
+		client := opts.newClient(config)
+		client.TokenCachePath = ""
+		if err := client.Authenticate(ctx); err != nil {
+			exitIfCancelled(ctx)
+			fatalf(exitCodeFor(err), "Error checking credentials, config not written: %v", err)
+		}
+		fmt.Println("Credentials are valid")
+	}
+	exitIfCancelled(ctx)
+
+	if err := writeConfigFile(configFile, config); err != nil {
+		fatalf(exitLocal, "Error writing config file: %v", err)
+	}
+	fmt.Printf("Wrote %s\n", configFile)
+}
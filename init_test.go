@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+func TestWriteConfigFileIsPrivate(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "zoom", "config.json")
+	if err := os.MkdirAll(filepath.Dir(configFile), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configFile, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := zoom.OAuthConfig{AccountID: "account", ClientID: "client", ClientSecret: "secret"}
+	if err := writeConfigFile(configFile, config); err != nil {
+		t.Fatalf("writeConfigFile: %v", err)
+	}
+
+	info, err := os.Stat(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("config file mode = %o, want 600", perm)
+	}
+	stored, err := readStoredConfig(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.ClientSecret != "secret" {
+		t.Errorf("client_secret = %q, want secret", stored.ClientSecret)
+	}
+}
@@ -0,0 +1,43 @@
+// Package atomicfile writes files that hold credentials, such as the
+// config file and the token cache, so that they are never seen half
+// written or readable by other users.
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// WriteFile replaces path with data, readable only by the user. The data
+// goes to a temporary file in the same directory that is synced and then
+// renamed over path, so that a concurrent reader, a crash or a full disk
+// leaves either the old file or the new one. If path is a symlink, the
+// file it points to is replaced rather than the link.
+func WriteFile(path string, data []byte) error {
+	if target, err := filepath.EvalSymlinks(path); err == nil {
+		path = target
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Chmod(0o600); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// invitationText returns the meeting as a ready-to-paste invitation laid
+// out like the one Zoom's web portal offers, for emails and chats.
+func invitationText(meeting *zoom.Meeting) string {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, "You are invited to a Zoom meeting.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "Topic:", meeting.Topic)
+	if when := invitationTime(meeting); when != "" {
+		fmt.Fprintln(&b, "Time:", when)
+	}
+	fmt.Fprintln(&b)
+
+	id := formatMeetingID(meeting.ID)
+	if meeting.JoinURL != "" {
+		fmt.Fprintln(&b, "Join Zoom Meeting")
+		fmt.Fprintln(&b, meeting.JoinURL)
+		fmt.Fprintln(&b)
+	}
+	fmt.Fprintln(&b, "Meeting ID:", id)
+	if meeting.Password != "" {
+		fmt.Fprintln(&b, "Passcode:", meeting.Password)
+	}
+
+	numbers := meeting.DialInNumbers()
+	if len(numbers) > 0 {
+		// One tap dials the number, then enters the meeting ID and the
+		// phone passcode; like Zoom's, it lists the first two numbers
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "---")
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "One tap mobile")
+		for _, n := range numbers[:min(len(numbers), 2)] {
+			tap := strings.ReplaceAll(n.Number, " ", "") + ",," + strconv.FormatInt(meeting.ID, 10) + "#"
+			if meeting.PSTNPassword != "" {
+				tap += ",,,,*" + meeting.PSTNPassword + "#"
+			}
+			fmt.Fprintln(&b, tap, dialInPlace(n))
+		}
+
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "---")
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "Dial by your location")
+		for _, n := range numbers {
+			fmt.Fprintln(&b, "•", n.Number, dialInPlace(n))
+		}
+		fmt.Fprintln(&b)
+		fmt.Fprintln(&b, "Meeting ID:", id)
+		if meeting.PSTNPassword != "" {
+			fmt.Fprintln(&b, "Passcode:", meeting.PSTNPassword)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// invitationTime renders the start time in the meeting's timezone, e.g.
+// "Jun 1, 2025 02:30 PM Europe/Berlin", or "" for meetings without one.
+func invitationTime(meeting *zoom.Meeting) string {
+	if meeting.StartTime == "" {
+		return ""
+	}
+	start, loc, err := meetingStart(meeting)
+	if err != nil {
+		return meeting.StartTime
+	}
+
+	when := start.In(loc).Format("Jan 2, 2006 03:04 PM")
+	if meeting.Timezone != "" {
+		return when + " " + meeting.Timezone
+	}
+	return when + " " + start.In(loc).Format("MST")
+}
+
+// dialInPlace is the "US (New York)" label of a dial-in number.
+func dialInPlace(n zoom.DialInNumber) string {
+	if n.City == "" {
+		return n.Country
+	}
+	return n.Country + " (" + n.City + ")"
+}
+
+// formatMeetingID groups the digits of a meeting ID the way Zoom shows
+// them: "123 4567 8901" for the usual 11 digits, "123 456 7890" for 10.
+func formatMeetingID(id int64) string {
+	digits := strconv.FormatInt(id, 10)
+
+	var groups []int
+	switch len(digits) {
+	case 11:
+		groups = []int{3, 4, 4}
+	case 10:
+		groups = []int{3, 3, 4}
+	default:
+		return digits
+	}
+
+	parts := make([]string, len(groups))
+	for i, n := range groups {
+		parts[i], digits = digits[:n], digits[n:]
+	}
+	return strings.Join(parts, " ")
+}
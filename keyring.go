@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringPrefix marks a config value kept in the OS keychain (macOS
+// Keychain, Windows Credential Manager or the Secret Service on Linux),
+// e.g. "keyring:zoom-meeting/secret" for the secret stored with service
+// "zoom-meeting" and user "secret".
+const keyringPrefix = "keyring:"
+
+// defaultKeyringService is the service of a reference with no "/".
+const defaultKeyringService = "zoom-meeting"
+
+// resolveKeyringSecret returns value, or the secret it refers to when it
+// starts with keyringPrefix.
+func resolveKeyringSecret(value string) (string, error) {
+	ref, ok := strings.CutPrefix(value, keyringPrefix)
+	if !ok {
+		return value, nil
+	}
+
+	service, user := defaultKeyringService, ref
+	if i := strings.LastIndex(ref, "/"); i >= 0 {
+		service, user = ref[:i], ref[i+1:]
+	}
+	if service == "" || user == "" {
+		return "", fmt.Errorf("invalid keyring reference %q: use keyring:<service>/<user>", value)
+	}
+
+	secret, err := keyring.Get(service, user)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", fmt.Errorf("no secret in the keychain for service %q and user %q", service, user)
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading %s from the keychain: %w", value, err)
+	}
+	return secret, nil
+}
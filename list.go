@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// listResult is the output of list.
+type listResult []zoom.Meeting
+
+func (r listResult) jsonValue() interface{} {
+	if r == nil {
+		return []zoom.Meeting{}
+	}
+	return []zoom.Meeting(r)
+}
+
+func (r listResult) table() ([]string, [][]string) {
+	header := []string{"ID", "TOPIC", "START", "DURATION", "JOIN URL"}
+	rows := make([][]string, len(r))
+	for i, m := range r {
+		rows[i] = []string{strconv.FormatInt(m.ID, 10), m.Topic, m.StartTime, strconv.Itoa(m.Duration), m.JoinURL}
+	}
+	return header, rows
+}
+
+// writePlain prints one meeting per line with the topic last, so that the
+// other fields split cleanly on spaces.
+func (r listResult) writePlain(w io.Writer) {
+	for _, m := range r {
+		fmt.Fprintf(w, "%d %s %s %s\n", m.ID, m.StartTime, m.JoinURL, m.Topic)
+	}
+}
+
+func runList(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting list", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	var format string
+	addFormatFlag(fs, &format, formatTable)
+	user := fs.String("user", "", "ID or email of the user whose meetings to list (default the app's own user)")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+	client.User = *user
+
+	meetings, err := client.ListMeetings(ctx)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error listing meetings: %v", err)
+	}
+
+	if len(meetings) == 0 && format != formatJSON {
+		fmt.Println("No upcoming meetings")
+		return
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(listResult(meetings)); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+}
@@ -0,0 +1,15 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// lockFile is a no-op where flock is unavailable; appends of a single
+// short line are not interleaved in practice.
+func lockFile(file *os.File) error {
+	return nil
+}
+
+func unlockFile(file *os.File) error {
+	return nil
+}
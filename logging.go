@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// logLevel is raised by --quiet and lowered by --verbose.
+var logLevel = new(slog.LevelVar)
+
+// logger receives all diagnostics; results are printed to stdout instead.
+var logger = slog.New(newCLIHandler(os.Stderr, logLevel))
+
+// sensitiveLogKeys are attribute keys whose values never reach the log.
+var sensitiveLogKeys = map[string]bool{
+	"authorization": true,
+	"access_token":  true,
+	"client_secret": true,
+	"password":      true,
+	"passcode":      true,
+	"refresh_token": true,
+}
+
+// cliHandler is a slog.Handler writing records in the same
+// "2006/01/02 15:04:05 message" shape as the standard log package, so the
+// default output looks as it always has. Groups are flattened.
+type cliHandler struct {
+	w     io.Writer
+	level slog.Leveler
+	attrs []slog.Attr
+	mu    *sync.Mutex
+}
+
+func newCLIHandler(w io.Writer, level slog.Leveler) *cliHandler {
+	return &cliHandler{w: w, level: level, mu: &sync.Mutex{}}
+}
+
+func (h *cliHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level.Level()
+}
+
+func (h *cliHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	b.WriteString(r.Time.Format("2006/01/02 15:04:05 "))
+
+	switch {
+	case r.Level >= slog.LevelError:
+		b.WriteString(paint(h.w, errorColor, "Error:") + " ")
+	case r.Level >= slog.LevelWarn:
+		b.WriteString(paint(h.w, warningColor, "Warning:") + " ")
+	case r.Level < slog.LevelInfo:
+		b.WriteString("Debug: ")
+	}
+	b.WriteString(zoom.Redact(r.Message))
+
+	writeAttr := func(a slog.Attr) bool {
+		value := zoom.Redact(a.Value.String())
+		if sensitiveLogKeys[strings.ToLower(a.Key)] {
+			value = "<redacted>"
+		}
+		if strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		b.WriteString(" " + a.Key + "=" + value)
+		return true
+	}
+	for _, a := range h.attrs {
+		writeAttr(a)
+	}
+	r.Attrs(writeAttr)
+	b.WriteByte('\n')
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+func (h *cliHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *h
+	clone.attrs = append(clone.attrs[:len(clone.attrs):len(clone.attrs)], attrs...)
+	return &clone
+}
+
+func (h *cliHandler) WithGroup(string) slog.Handler {
+	return h
+}
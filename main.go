@@ -1,185 +1,691 @@
 package main
 
 import (
-	"bytes"
-	"encoding/base64"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/atotto/clipboard"
+	"github.com/optiowl/zoom-meeting/zoom"
 	"github.com/skratchdot/open-golang/open"
 )
 
+// Default meeting details used when the corresponding flag is omitted.
 const (
-	apiURL  = "https://api.zoom.us/v2/users/me/meetings"
-	authURL = "https://zoom.us/oauth/token?grant_type=account_credentials"
+	defaultTopic    = "My Meeting"
+	defaultType     = 2
+	defaultDuration = 60
 )
 
-// OAuthConfig holds the OAuth configuration details.
-type OAuthConfig struct {
-	AccountID    string `json:"account_id"`
-	ClientID     string `json:"client_id"`
-	ClientSecret string `json:"client_secret"`
+func copyToClipboard(text string) error {
+	return clipboard.WriteAll(text)
+}
+
+func openURL(url string) error {
+	return open.Run(url)
+}
+
+// parseCopyTemplate parses --copy-template and runs it once against an
+// empty meeting, so that unknown fields are reported before any request.
+func parseCopyTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("copy-template").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --copy-template: %w", err)
+	}
+	if err := tmpl.Execute(io.Discard, &zoom.Meeting{}); err != nil {
+		return nil, fmt.Errorf("invalid --copy-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// clipboardContents renders what to copy: --copy-template if given,
+// otherwise the field chosen with --copy.
+func clipboardContents(meeting *zoom.Meeting, opts cliOptions) (string, error) {
+	if opts.copyTemplate == nil {
+		return clipboardText(meeting, opts.Copy), nil
+	}
+
+	var b strings.Builder
+	if err := opts.copyTemplate.Execute(&b, meeting); err != nil {
+		return "", err
+	}
+	return b.String(), nil
 }
 
-// MeetingDetails holds information about the meeting.
-type MeetingDetails struct {
-	Topic    string `json:"topic"`
-	Type     int    `json:"type"`
-	Start    string `json:"start_time,omitempty"`
-	Duration int    `json:"duration,omitempty"`
+// clipboardText returns the part of the meeting selected with --copy.
+func clipboardText(meeting *zoom.Meeting, field string) string {
+	switch field {
+	case "start_url":
+		return meeting.StartURL
+	case "id":
+		return strconv.FormatInt(meeting.ID, 10)
+	case "invite":
+		return invitationText(meeting)
+	default:
+		return meetingLink(meeting)
+	}
 }
 
-// ResponseData holds the response data from Zoom.
-type ResponseData struct {
-	JoinURL string `json:"join_url"`
+// meetingLink returns the join URL, or the meeting ID for a pre-scheduled
+// meeting, which Zoom gives no join URL until its time is set.
+func meetingLink(meeting *zoom.Meeting) string {
+	if meeting.JoinURL == "" {
+		return strconv.FormatInt(meeting.ID, 10)
+	}
+	return meeting.JoinURL
 }
 
-// OAuthTokenResponse represents the OAuth token response.
-type OAuthTokenResponse struct {
-	AccessToken string `json:"access_token"`
+func main() {
+	ctx, stop := signalContext()
+	defer stop()
+
+	args := os.Args[1:]
+	if len(args) > 0 {
+		switch args[0] {
+		case "init":
+			runInit(ctx, args[1:])
+			return
+		case "list":
+			runList(ctx, args[1:])
+			return
+		case "history":
+			runHistory(ctx, args[1:])
+			return
+		case "register":
+			runRegister(ctx, args[1:])
+			return
+		case "update":
+			runUpdate(ctx, args[1:])
+			return
+		case "get":
+			runGet(ctx, args[1:])
+			return
+		case "delete":
+			runDelete(ctx, args[1:])
+			return
+		case "create":
+			runCreate(ctx, args[1:])
+			return
+		case "whoami":
+			runWhoami(ctx, args[1:])
+			return
+		case "templates":
+			runTemplates(ctx, args[1:])
+			return
+		case "tz":
+			runTZ(ctx, args[1:])
+			return
+		case "profiles":
+			runProfiles(ctx, args[1:])
+			return
+		case "config":
+			runConfig(ctx, args[1:])
+			return
+		case "daemon":
+			runDaemon(ctx, args[1:])
+			return
+		case "webinar":
+			runWebinar(ctx, args[1:])
+			return
+		case "version":
+			runVersion(ctx, args[1:])
+			return
+		}
+	}
+
+	runCreate(ctx, args)
 }
 
-func loadOAuthConfig() OAuthConfig {
-	homeDir, err := os.UserHomeDir()
+func runCreate(ctx context.Context, args []string) {
+	// Parse command-line flags
+	opts, err := parseFlags(args)
 	if err != nil {
-		log.Fatalf("Error finding user home directory: %v", err)
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if opts.Version {
+		fmt.Println(versionString())
+		return
 	}
+	opts.apply()
 
-	configFile := filepath.Join(homeDir, ".zoom-meeting.config.json")
-	fileContent, err := os.ReadFile(configFile)
+	// Set your meeting details: built-in defaults, then the config file's
+	// defaults, then the template and stdin, with flags applied last
+	defaults, err := loadDefaults(opts.globalOptions)
 	if err != nil {
-		log.Fatalf("Error reading config file: %v", err)
+		fatalf(exitConfig, "Error loading config: %v", err)
 	}
 
-	var config OAuthConfig
-	if err := json.Unmarshal(fileContent, &config); err != nil {
-		log.Fatalf("Error parsing config file: %v", err)
+	if opts.onSuccess == nil && defaults.OnSuccess != "" {
+		opts.onSuccess, err = parseHookTemplate(defaults.OnSuccess)
+		if err != nil {
+			fatalf(exitConfig, "Error loading config: %v", err)
+		}
 	}
 
-	if config.AccountID == "" || config.ClientID == "" || config.ClientSecret == "" {
-		log.Fatalf("Account ID or Client ID or Client Secret not found in config file")
+	if opts.SlackWebhook == "" && defaults.SlackWebhook != "" {
+		if err := validateSlackWebhook(defaults.SlackWebhook); err != nil {
+			fatalf(exitConfig, "Error loading config: %v", err)
+		}
+		opts.SlackWebhook = defaults.SlackWebhook
+	}
+	if opts.SlackWebhook != "" && opts.slackTemplate == nil {
+		opts.slackTemplate, err = parseSlackTemplate(defaults.SlackTemplate)
+		if err != nil {
+			fatalf(exitConfig, "Error loading config: %v", err)
+		}
 	}
 
-	return config
-}
+	if defaults.Transcription && !opts.isSet("transcription") && opts.Recording == "" {
+		opts.Transcription = true
+	}
 
-func getOAuthToken(config OAuthConfig) string {
-	client := &http.Client{}
+	base := defaults.apply(defaultMeetingDetails())
+	if opts.Template != "" {
+		base, err = loadMeetingTemplate(opts.Template, base)
+		if err != nil {
+			fatalf(exitConfig, "Error loading template: %v", err)
+		}
+	}
+	if opts.Stdin {
+		base, err = readMeetingDetails(stdin, base)
+		if err != nil {
+			fatalf(exitConfig, "Error loading meeting details: %v", err)
+		}
+	}
+	if opts.Edit {
+		base, err = applyDetailFlags(opts, base)
+		if err != nil {
+			fatalf(exitConfig, "Error preparing meeting: %v", err)
+		}
+		base, err = editMeetingDetails(ctx, base)
+		if err != nil {
+			exitIfCancelled(ctx)
+			fatalf(exitConfig, "Error editing meeting details: %v", err)
+		}
+	}
 
-	// Encode Client ID and Client Secret
-	auth := base64.StdEncoding.EncodeToString([]byte(config.ClientID + ":" + config.ClientSecret))
+	// --raw-body replaces the meeting built from the flags; its details are
+	// still decoded for the checks below
+	var rawBody json.RawMessage
+	var meetingDetails zoom.MeetingDetails
+	if opts.RawBody != "" {
+		rawBody, err = loadRawBody(opts.RawBody)
+		if err != nil {
+			fatalf(exitConfig, "Error loading meeting details: %v", err)
+		}
+		// A field of an unexpected type only weakens the checks below; Zoom
+		// has the final say
+		_ = json.Unmarshal(rawBody, &meetingDetails)
+	} else {
+		meetingDetails, err = buildMeetingDetails(opts, base)
+		if err != nil {
+			fatalf(exitConfig, "Error preparing meeting: %v", err)
+		}
+	}
+	if opts.ICS != "" {
+		if err := validateICSMeeting(meetingDetails); err != nil {
+			fatalf(exitConfig, "Error preparing meeting: %v", err)
+		}
+	}
 
-	// Create request with the required body parameters
-	data := "grant_type=account_credentials&account_id=" + config.AccountID
-	req, err := http.NewRequest("POST", authURL, bytes.NewBufferString(data))
+	batch, err := numberedDetails(meetingDetails, opts.Count, opts.GeneratePassword)
 	if err != nil {
-		log.Fatalf("Error creating request: %v", err)
+		fatalf(exitConfig, "Error preparing meeting: %v", err)
 	}
 
-	// Add headers
-	req.Header.Add("Authorization", "Basic "+auth)
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	// A dry run sends nothing, so it needs no credentials
+	if opts.DryRun {
+		client := opts.newClient(zoom.OAuthConfig{})
+		client.User = opts.User
+
+		var payloads []interface{}
+		if rawBody != nil {
+			payloads = append(payloads, rawBody)
+		} else {
+			for _, details := range batch {
+				payloads = append(payloads, details)
+			}
+		}
+		for _, payload := range payloads {
+			if err := printDryRun("POST", client.MeetingsURL(), payload); err != nil {
+				fatalf(exitLocal, "Error printing request: %v", err)
+			}
+		}
+		return
+	}
 
-	// Make request
-	resp, err := client.Do(req)
+	// Load OAuth configuration
+	config, err := loadOAuthConfig(opts.globalOptions)
 	if err != nil {
-		log.Fatalf("Error retrieving OAuth token: %v", err)
+		fatalf(exitConfig, "Error loading config: %v", err)
 	}
-	defer resp.Body.Close()
 
-	// Decode response
-	var tokenResp OAuthTokenResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		log.Fatalf("Error decoding OAuth response: %v", err)
+	client := opts.newClient(config)
+	client.User = opts.User
+	client.RetryCreate = opts.RetryCreate
+
+	// Transcripts are a setting of the host, not of the meeting, so check
+	// it rather than leave the meeting recorded without one
+	if opts.Transcription && rawBody == nil {
+		if err := checkTranscription(ctx, client); err != nil {
+			exitIfCancelled(ctx)
+			fatalf(exitCodeFor(err), "Error checking transcription: %v", err)
+		}
 	}
 
-	if tokenResp.AccessToken == "" {
-		log.Fatalf("Failed to retrieve access token")
+	create := client.CreateMeeting
+	if rawBody != nil {
+		create = func(ctx context.Context, _ zoom.MeetingDetails) (*zoom.Meeting, error) {
+			return client.CreateMeetingRaw(ctx, rawBody)
+		}
 	}
 
-	return tokenResp.AccessToken
-}
-
-func createZoomMeeting(details MeetingDetails, config OAuthConfig) (string, error) {
-	client := &http.Client{}
-	meetingDetails, err := json.Marshal(details)
+	// The result goes to stdout or the --output file, opened first so that
+	// a bad path fails before any meeting is created
+	output, err := openOutput(opts.Output)
 	if err != nil {
-		return "", err
+		fatalf(exitLocal, "Error opening output file: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", apiURL, bytes.NewBuffer(meetingDetails))
-	if err != nil {
-		return "", err
+	// Create the meetings one after another to stay within rate limits;
+	// a failure does not stop the rest, so no created meeting goes unseen
+	var meetings []*zoom.Meeting
+	var errs []error
+	for _, details := range batch {
+		meeting, err := create(ctx, details)
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			if len(batch) > 1 {
+				err = fmt.Errorf("%s: %w", details.Topic, err)
+			}
+			errs = append(errs, err)
+			continue
+		}
+		meetings = append(meetings, meeting)
+
+		if !opts.NoHistory {
+			if err := recordHistory(opts.HistoryFile, meeting); err != nil {
+				logger.Warn("could not record meeting in history", "error", err)
+			}
+		}
+	}
+	if len(meetings) == 0 {
+		exitIfCancelled(ctx)
+		err := errors.Join(errs...)
+		fatalf(exitCodeFor(err), "Error creating meeting: %v", err)
 	}
 
-	// Use OAuth token for authorization
-	req.Header.Add("Authorization", "Bearer "+getOAuthToken(config))
-	req.Header.Add("Content-Type", "application/json")
+	out := outputWriter{w: output, format: opts.Format}
+	if err := out.write(createResult{meetings: meetings, batch: len(batch) > 1, opts: opts}); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return "", err
+	// With JSON or --quiet on stdout, it carries nothing but the JSON
+	// document or the join URLs
+	textOutput := io.Writer(os.Stdout)
+	if (opts.Format == formatJSON || opts.Quiet) && output == os.Stdout {
+		textOutput = os.Stderr
 	}
-	defer resp.Body.Close()
 
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
+	if output != os.Stdout {
+		if err := output.Close(); err != nil {
+			fatalf(exitLocal, "Error writing output file: %v", err)
+		}
 	}
 
-	var responseData ResponseData
-	if err := json.Unmarshal(data, &responseData); err != nil {
-		return "", err
+	if opts.QR {
+		for _, meeting := range meetings {
+			if meeting.JoinURL == "" {
+				continue
+			}
+			if err := printQRCode(textOutput, meeting.JoinURL); err != nil {
+				fatalf(exitLocal, "Error rendering QR code: %v", err)
+			}
+		}
+	}
+
+	// The meeting exists by now, so copying and opening are attempted
+	// independently of each other, and a missing clipboard or browser, as
+	// on a headless machine, is only worth a warning
+	var actions []postAction
+	if opts.ICS != "" {
+		actions = append(actions, postAction{
+			done:     "Wrote the calendar file " + opts.ICS,
+			failed:   "could not write the calendar file",
+			run:      func() error { return writeICS(opts.ICS, meetings) },
+			required: true,
+		})
+	}
+	if !opts.NoCopy {
+		actions = append(actions, postAction{
+			done:   "Copied to the clipboard",
+			failed: "could not copy to the clipboard",
+			run: func() error {
+				// Copy the selected field, one line per meeting
+				texts := make([]string, len(meetings))
+				for i, meeting := range meetings {
+					text, err := clipboardContents(meeting, opts)
+					if err != nil {
+						return fmt.Errorf("rendering --copy-template: %w", err)
+					}
+					texts[i] = text
+				}
+				return copyToClipboard(strings.Join(texts, "\n"))
+			},
+		})
 	}
 
-	return responseData.JoinURL, nil
+	// Run the --on-success command for each meeting; it failing does not
+	// fail the run either
+	if opts.onSuccess != nil {
+		for _, meeting := range meetings {
+			meeting := meeting
+			actions = append(actions, postAction{
+				done:     fmt.Sprintf("Ran the --on-success command for meeting %d", meeting.ID),
+				failed:   fmt.Sprintf("the --on-success command failed for meeting %d", meeting.ID),
+				run:      func() error { return runHook(ctx, opts.onSuccess, meeting) },
+				required: true,
+			})
+		}
+	}
+
+	// Post each meeting to Slack
+	if opts.SlackWebhook != "" {
+		slackClient := &http.Client{Timeout: opts.Timeout, Transport: opts.newTransport()}
+		for _, meeting := range meetings {
+			meeting := meeting
+			actions = append(actions, postAction{
+				done:   fmt.Sprintf("Posted meeting %d to Slack", meeting.ID),
+				failed: fmt.Sprintf("could not post meeting %d to Slack", meeting.ID),
+				run: func() error {
+					return postToSlack(ctx, slackClient, opts.SlackWebhook, opts.slackTemplate, meeting)
+				},
+				required: true,
+			})
+		}
+	}
+
+	// Waiting for the start time or the open delay comes last, so that the
+	// hook and Slack are not held back by it
+	switch {
+	case opts.Wait:
+		// Start the meeting as host once it is due
+		actions = append(actions, postAction{
+			done:   "Opened the start URL",
+			failed: "could not open the start URL, open it yourself",
+			run: func() error {
+				if meetings[0].StartTime != "" {
+					if err := waitForStart(ctx, meetings[0].StartTime); err != nil {
+						return fmt.Errorf("waiting for the meeting: %w", err)
+					}
+				}
+				return openURL(meetings[0].StartURL)
+			},
+			onFailure: func() {
+				if ctx.Err() == nil {
+					fmt.Fprintln(textOutput, "Start URL:", meetings[0].StartURL)
+				}
+			},
+		})
+
+	case !opts.NoOpen && !opts.Quiet && len(batch) == 1 && meetings[0].JoinURL != "":
+		// Open the meeting link, or start the meeting as host with
+		// --open-target start; a batch is not opened tab by tab, scripts
+		// using --quiet want only the link, and a pre-scheduled meeting
+		// has none yet
+		target := meetingOpenURL(meetings[0].JoinURL, opts.OpenWith)
+		if opts.OpenTarget == "start" {
+			target = meetings[0].StartURL
+		}
+		actions = append(actions, postAction{
+			done:   "Opened the meeting link",
+			failed: "could not open the meeting link",
+			run: func() error {
+				if err := sleepContext(ctx, opts.OpenDelay); err != nil {
+					return err
+				}
+				return openURL(target)
+			},
+			onFailure: func() {
+				if opts.OpenTarget == "start" && !opts.ShowStartURL && ctx.Err() == nil {
+					fmt.Fprintln(textOutput, "Start URL:", target)
+				}
+			},
+		})
+	}
+
+	if failed := runPostActions(actions); len(failed) > 0 && opts.Rollback {
+		// A step stopped by Ctrl-C did not fail; the user wants out, not
+		// the meetings gone
+		exitIfCancelled(ctx)
+		rollBack(client, meetings, opts)
+		fatalf(exitLocal, "Error after creating the meeting, rolled back: %v", errors.Join(failed...))
+	}
+
+	if len(errs) > 0 || ctx.Err() != nil {
+		exitIfCancelled(ctx)
+		err := errors.Join(errs...)
+		fatalf(exitCodeFor(err), "Error creating %d of %d meetings:\n%v", len(errs), len(batch), err)
+	}
 }
 
-func copyToClipboard(text string) error {
-	return clipboard.WriteAll(text)
+// postAction is something done with a meeting once it is created, such as
+// copying its link, whose failure does not undo the meeting unless it is
+// required and --rollback-on-failure is given.
+type postAction struct {
+	// done is logged when run succeeds and failed as a warning when it
+	// does not, followed by onFailure if set.
+	done      string
+	failed    string
+	run       func() error
+	onFailure func()
+
+	// required marks steps a pipeline depends on, such as posting to
+	// Slack, rather than conveniences such as opening the browser.
+	required bool
 }
 
-func openURL(url string) error {
-	return open.Run(url)
+// runPostActions runs every action, whether or not the others fail, and
+// then reports which succeeded. It returns the errors of the required
+// actions that failed.
+func runPostActions(actions []postAction) []error {
+	errs := make([]error, len(actions))
+	for i, action := range actions {
+		errs[i] = action.run()
+	}
+
+	var failed []error
+	for i, action := range actions {
+		if errs[i] == nil {
+			logger.Info(action.done)
+			continue
+		}
+		logger.Warn(action.failed, "error", errs[i])
+		if action.onFailure != nil {
+			action.onFailure()
+		}
+		if action.required {
+			failed = append(failed, fmt.Errorf("%s: %w", action.failed, errs[i]))
+		}
+	}
+	return failed
 }
 
-func main() {
-	// Load OAuth configuration
-	config := loadOAuthConfig()
+// rollbackTimeout bounds the deletions of rollBack. They do not use the
+// run's context, so that a Ctrl-C while they are sent does not leave some
+// of the meetings behind.
+const rollbackTimeout = 30 * time.Second
+
+// rollBack deletes the meetings just created, for --rollback-on-failure,
+// logging each one so that none is left behind unnoticed. A deleted
+// meeting is taken out of the history again, which only lists meetings
+// that exist.
+func rollBack(client *zoom.Client, meetings []*zoom.Meeting, opts cliOptions) {
+	ctx, cancel := context.WithTimeout(context.Background(), rollbackTimeout)
+	defer cancel()
+
+	for _, meeting := range meetings {
+		if err := client.DeleteMeeting(ctx, strconv.FormatInt(meeting.ID, 10)); err != nil {
+			logger.Error("rollback failed, delete the meeting yourself", "id", meeting.ID, "error", err)
+			continue
+		}
+		logger.Warn("rolled back: deleted meeting", "id", meeting.ID, "topic", meeting.Topic)
+
+		if opts.NoHistory {
+			continue
+		}
+		path, err := historyPath(opts.HistoryFile)
+		if err == nil {
+			_, err = forgetHistory(path, meeting.ID)
+		}
+		if err != nil {
+			logger.Warn("could not remove the deleted meeting from the history", "id", meeting.ID, "error", err)
+		}
+	}
+}
 
-	// Get current time in ISO 8601 format
-	currentTime := time.Now().Format(time.RFC3339)
+// numberedDetails returns the details of every meeting to create: details
+// alone, or for --count N that many copies with " #1" to " #N" appended to
+// the topic. With a passcodeLength each copy gets a passcode of its own,
+// so that knowing one meeting's does not let anyone into the others.
+func numberedDetails(details zoom.MeetingDetails, count, passcodeLength int) ([]zoom.MeetingDetails, error) {
+	if count <= 1 {
+		return []zoom.MeetingDetails{details}, nil
+	}
 
-	// Set your meeting details
-	meetingDetails := MeetingDetails{
-		Topic:    "My Meeting",
-		Type:     2,           // 1 for instant meeting, 2 for scheduled meeting
-		Start:    currentTime, // Set your desired time
-		Duration: 60,          // Duration in minutes
+	batch := make([]zoom.MeetingDetails, count)
+	for i := range batch {
+		batch[i] = details
+		batch[i].Topic = fmt.Sprintf("%s #%d", details.Topic, i+1)
+		if passcodeLength > 0 {
+			passcode, err := generatePasscode(passcodeLength)
+			if err != nil {
+				return nil, err
+			}
+			batch[i].Password = passcode
+		}
 	}
+	return batch, nil
+}
 
-	// Create Zoom meeting
-	meetingLink, err := createZoomMeeting(meetingDetails, config)
-	if err != nil {
-		log.Fatalf("Error creating meeting: %v", err)
+// createResult is the output of creating one meeting or a --count batch.
+type createResult struct {
+	meetings []*zoom.Meeting
+	batch    bool
+	opts     cliOptions
+}
+
+// jsonValue is the meeting, or an array for a batch.
+func (r createResult) jsonValue() interface{} {
+	if !r.batch {
+		return r.meetings[0]
+	}
+	return r.meetings
+}
+
+func (r createResult) table() ([]string, [][]string) {
+	header := []string{"ID", "TOPIC", "START", "DURATION", "PASSCODE", "JOIN URL"}
+	rows := make([][]string, len(r.meetings))
+	for i, m := range r.meetings {
+		rows[i] = []string{strconv.FormatInt(m.ID, 10), m.Topic, m.StartTime, strconv.Itoa(m.Duration), m.Password, m.JoinURL}
+	}
+	return header, rows
+}
+
+func (r createResult) writePlain(w io.Writer) {
+	for i, meeting := range r.meetings {
+		if i > 0 && !r.opts.Quiet {
+			fmt.Fprintln(w)
+		}
+		if r.opts.Invite {
+			fmt.Fprintln(w, invitationText(meeting))
+			continue
+		}
+		printMeeting(w, meeting, r.opts)
+	}
+}
+
+// printMeeting prints the meeting's links and ID, and its dial-in numbers
+// with --dial-in, or with --quiet only the join link.
+func printMeeting(w io.Writer, meeting *zoom.Meeting, opts cliOptions) {
+	if opts.Quiet {
+		fmt.Fprintln(w, meetingLink(meeting))
+		return
+	}
+
+	if meeting.JoinURL != "" && usesPMI(meeting) {
+		fmt.Fprintln(w, "Meeting link (personal room):", paint(w, linkColor, meeting.JoinURL))
+	} else if meeting.JoinURL != "" {
+		fmt.Fprintln(w, "Meeting link:", paint(w, linkColor, meeting.JoinURL))
+	} else {
+		fmt.Fprintln(w, "Meeting link: none until the start time is set with zoom-meeting update --start")
 	}
+	fmt.Fprintln(w, "Meeting ID:", meeting.ID)
+	if meeting.Password != "" && opts.GeneratePassword > 0 {
+		fmt.Fprintln(w, "Passcode (generated):", paint(w, passcodeColor, meeting.Password))
+	} else if meeting.Password != "" {
+		fmt.Fprintln(w, "Passcode:", paint(w, passcodeColor, meeting.Password))
+	}
+	// The start URL carries the host's ZAK token, so it is only shown on
+	// request
+	if opts.ShowStartURL {
+		fmt.Fprintln(w, "Start URL:", paint(w, linkColor, meeting.StartURL))
+	}
+	if meeting.RegistrationURL != "" {
+		fmt.Fprintln(w, "Registration link:", paint(w, linkColor, meeting.RegistrationURL))
+	}
+	if opts.DialIn {
+		fmt.Fprintln(w, "Dial-in:", formatDialIn(meeting.DialInNumbers()))
+	}
+	if meeting.Settings != nil && meeting.Settings.AutoRecording == zoom.RecordingCloud {
+		if opts.Transcription {
+			fmt.Fprintln(w, "Recording: cloud with an audio transcript; both will be available in the Zoom web portal under Recordings")
+		} else {
+			fmt.Fprintln(w, "Recording: cloud; recordings will be available in the Zoom web portal under Recordings")
+		}
+	}
+}
 
-	fmt.Println("Meeting link:", meetingLink)
+// usesPMI reports whether the meeting is held in the host's personal
+// meeting room, so that its link and ID are the host's permanent ones.
+func usesPMI(meeting *zoom.Meeting) bool {
+	return meeting.Type == zoom.TypePMI || meeting.Settings != nil && meeting.Settings.UsePMI != nil && *meeting.Settings.UsePMI
+}
+
+// formatDialIn renders dial-in numbers as "US: +1 646 558 8656 / UK: +44
+// 203 481 5237".
+func formatDialIn(numbers []zoom.DialInNumber) string {
+	if len(numbers) == 0 {
+		return "no dial-in numbers are configured for this account"
+	}
+
+	parts := make([]string, len(numbers))
+	for i, n := range numbers {
+		parts[i] = n.Country + ": " + n.Number
+	}
+	return strings.Join(parts, " / ")
+}
 
-	// Copy link to clipboard
-	if err := copyToClipboard(meetingLink); err != nil {
-		log.Fatalf("Error copying to clipboard: %v", err)
+// openOutput returns where --output sends the result: stdout for "" or
+// "-", otherwise the file, created along with its parent directories.
+func openOutput(path string) (*os.File, error) {
+	if path == "" || path == "-" {
+		return os.Stdout, nil
 	}
 
-	// Open the meeting link
-	if err := openURL(meetingLink); err != nil {
-		log.Fatalf("Error opening URL: %v", err)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
 	}
+	return os.Create(path)
 }
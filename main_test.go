@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+func TestNumberedDetailsGeneratesPasscodeEach(t *testing.T) {
+	batch, err := numberedDetails(zoom.MeetingDetails{Topic: "Interview", Password: "first1"}, 3, defaultPasscodeLength)
+	if err != nil {
+		t.Fatalf("numberedDetails: %v", err)
+	}
+	if len(batch) != 3 {
+		t.Fatalf("batch of %d, want 3", len(batch))
+	}
+
+	seen := map[string]bool{}
+	for i, details := range batch {
+		if len(details.Password) != defaultPasscodeLength {
+			t.Errorf("meeting %d: passcode %q, want %d characters", i+1, details.Password, defaultPasscodeLength)
+		}
+		if seen[details.Password] {
+			t.Errorf("meeting %d: passcode %q is shared with another meeting", i+1, details.Password)
+		}
+		seen[details.Password] = true
+	}
+	if batch[2].Topic != "Interview #3" {
+		t.Errorf("topic = %q, want Interview #3", batch[2].Topic)
+	}
+}
+
+func TestNumberedDetailsKeepsGivenPasscode(t *testing.T) {
+	batch, err := numberedDetails(zoom.MeetingDetails{Topic: "Interview", Password: "team42"}, 2, 0)
+	if err != nil {
+		t.Fatalf("numberedDetails: %v", err)
+	}
+	for i, details := range batch {
+		if details.Password != "team42" {
+			t.Errorf("meeting %d: passcode %q, want the given team42", i+1, details.Password)
+		}
+	}
+}
+
+func TestRollBackForgetsDeletedMeetings(t *testing.T) {
+	var deleted []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(zoom.OAuthTokenResponse{AccessToken: "test-token", ExpiresIn: 3600})
+	})
+	mux.HandleFunc("/v2/meetings/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		// The second meeting cannot be deleted, so it stays in the history
+		if r.URL.Path == "/v2/meetings/2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := &zoom.Client{
+		Config:     zoom.OAuthConfig{AccountID: "account", ClientID: "client", ClientSecret: "secret"},
+		BaseURL:    server.URL + "/v2",
+		AuthURL:    server.URL + "/oauth/token",
+		HTTPClient: server.Client(),
+	}
+
+	var opts cliOptions
+	opts.HistoryFile = filepath.Join(t.TempDir(), "history.jsonl")
+	meetings := []*zoom.Meeting{{ID: 1, Topic: "Standup #1"}, {ID: 2, Topic: "Standup #2"}}
+	for _, meeting := range append([]*zoom.Meeting{{ID: 3, Topic: "Earlier"}}, meetings...) {
+		if err := appendHistory(opts.HistoryFile, meeting); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rollBack(client, meetings, opts)
+
+	if len(deleted) != 1 || deleted[0] != "/v2/meetings/1" {
+		t.Errorf("deleted %v, want only /v2/meetings/1", deleted)
+	}
+	entries, err := readHistory(opts.HistoryFile, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	if want := []int64{3, 2}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("history holds meetings %v, want %v", ids, want)
+	}
+}
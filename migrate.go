@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/optiowl/zoom-meeting/internal/atomicfile"
+)
+
+// defaultMigratedProfile names the profile a flat config's account moves to.
+const defaultMigratedProfile = "default"
+
+// migrateConfig converts a flat config to the profiles format: the account
+// moves under profiles[name], which becomes the default_profile, and the
+// meeting defaults stay at the top level. It returns nil for a config that
+// already has profiles.
+func migrateConfig(fileContent []byte, name string) ([]byte, error) {
+	var file map[string]interface{}
+	if err := json.Unmarshal(fileContent, &file); err != nil {
+		return nil, describeJSONError(fileContent, err)
+	}
+	if _, ok := file["profiles"]; ok {
+		return nil, nil
+	}
+
+	// Everything but the file-wide keys belongs to the account, including
+	// fields this version does not know
+	account := map[string]interface{}{}
+	for key, value := range file {
+		switch key {
+		case "defaults", "default_profile":
+			continue
+		}
+		account[key] = value
+		delete(file, key)
+	}
+	if len(account) == 0 {
+		return nil, errors.New("the config file holds no account to move into a profile")
+	}
+	file["profiles"] = map[string]interface{}{name: account}
+	file["default_profile"] = name
+
+	migrated, err := json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	return append(migrated, '\n'), nil
+}
+
+func runConfig(ctx context.Context, args []string) {
+	if len(args) == 0 || args[0] != "migrate" {
+		fmt.Fprintf(os.Stderr, "Usage: zoom-meeting config migrate [flags]\n")
+		os.Exit(2)
+	}
+	runConfigMigrate(ctx, args[1:])
+}
+
+// runConfigMigrate rewrites a flat config file in the profiles format,
+// keeping the original next to it with a .bak suffix.
+func runConfigMigrate(_ context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting config migrate", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting config migrate [flags]\n\nMoves the account of a single-account config file into a profile. Flags:\n")
+		fs.PrintDefaults()
+	}
+	name := fs.String("name", defaultMigratedProfile, "name of the profile the account moves to")
+	dryRun := fs.Bool("dry-run", false, "print the migrated config file instead of writing it")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if *name == "" {
+		fatalf(exitConfig, "Error parsing flags: --name must not be empty")
+	}
+	opts.apply()
+
+	configFile, err := configPath(opts.Config)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+	info, err := os.Stat(configFile)
+	if os.IsNotExist(err) {
+		fatalf(exitConfig, "Error loading config: config file %s does not exist", configFile)
+	}
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+	fileContent, err := os.ReadFile(configFile)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: reading config file: %v", err)
+	}
+
+	migrated, err := migrateConfig(fileContent, *name)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: parsing config file %s: %v", configFile, err)
+	}
+	if migrated == nil {
+		fmt.Printf("%s already uses profiles, nothing to migrate\n", configFile)
+		return
+	}
+
+	if *dryRun {
+		os.Stdout.Write(migrated)
+		return
+	}
+
+	// An existing backup may be the only copy of an older config
+	backup := configFile + ".bak"
+	if _, err := os.Stat(backup); err == nil {
+		fatalf(exitConfig, "Error migrating config: %s already exists; move it away first", backup)
+	}
+	if err := os.WriteFile(backup, fileContent, info.Mode().Perm()); err != nil {
+		fatalf(exitLocal, "Error migrating config: writing backup: %v", err)
+	}
+	// Replaced in one step, so that a crash leaves the old file or the new
+	if err := atomicfile.WriteFile(configFile, migrated); err != nil {
+		fatalf(exitLocal, "Error migrating config: writing config file: %v", err)
+	}
+
+	fmt.Printf("Migrated %s to profile %q, the original is in %s\n", configFile, *name, backup)
+}
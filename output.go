@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats for --format.
+const (
+	formatPlain = "plain"
+	formatJSON  = "json"
+	formatTable = "table"
+)
+
+// result is what a command outputs, in each of the formats.
+type result interface {
+	// jsonValue is the document written for --format json.
+	jsonValue() interface{}
+	// table returns the column names and rows for --format table.
+	table() (header []string, rows [][]string)
+	// writePlain writes the human-readable text for --format plain.
+	writePlain(w io.Writer)
+}
+
+// outputWriter renders command results in the chosen format, so that
+// commands only hand it their data.
+type outputWriter struct {
+	w      io.Writer
+	format string
+}
+
+func (o outputWriter) write(r result) error {
+	switch o.format {
+	case formatJSON:
+		return printJSON(o.w, r.jsonValue())
+	case formatTable:
+		header, rows := r.table()
+		tw := tabwriter.NewWriter(o.w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, row := range rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	default:
+		r.writePlain(o.w)
+		return nil
+	}
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// addFormatFlag registers --format on fs with the command's default.
+func addFormatFlag(fs *flag.FlagSet, format *string, defaultFormat string) {
+	fs.StringVar(format, "format", defaultFormat, "output format: plain, json or table")
+}
+
+func validateFormat(format string) error {
+	switch format {
+	case formatPlain, formatJSON, formatTable:
+		return nil
+	}
+	return fmt.Errorf("invalid --format value %q: must be plain, json or table", format)
+}
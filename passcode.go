@@ -0,0 +1,80 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Zoom passcodes are at most 10 characters of letters, digits and @ - _ *.
+// Generated ones use only letters and digits, which survive being read
+// out or pasted anywhere.
+const (
+	passcodeAlphabet      = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	defaultPasscodeLength = 8
+	maxPasscodeLength     = 10
+)
+
+// passcodeLengthFlag is --generate-password, which takes an optional
+// length: bare, it uses the default length.
+type passcodeLengthFlag struct {
+	length *int
+}
+
+func (f passcodeLengthFlag) String() string {
+	if f.length == nil || *f.length == 0 {
+		return ""
+	}
+	return strconv.Itoa(*f.length)
+}
+
+func (f passcodeLengthFlag) Set(value string) error {
+	switch value {
+	case "true":
+		*f.length = defaultPasscodeLength
+		return nil
+	case "false":
+		*f.length = 0
+		return nil
+	}
+
+	length, err := strconv.Atoi(value)
+	if err != nil || length < 1 || length > maxPasscodeLength {
+		return fmt.Errorf("must be a length between 1 and %d", maxPasscodeLength)
+	}
+	*f.length = length
+	return nil
+}
+
+// IsBoolFlag lets the flag be given without a value.
+func (f passcodeLengthFlag) IsBoolFlag() bool {
+	return true
+}
+
+// generatePasscode returns a random passcode of the given length. Passcodes
+// of two or more characters have both a letter and a digit, which accounts
+// requiring a stronger passcode insist on.
+func generatePasscode(length int) (string, error) {
+	max := big.NewInt(int64(len(passcodeAlphabet)))
+	for {
+		var b strings.Builder
+		for i := 0; i < length; i++ {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", fmt.Errorf("generating passcode: %w", err)
+			}
+			b.WriteByte(passcodeAlphabet[n.Int64()])
+		}
+
+		passcode := b.String()
+		if length < 2 || (strings.ContainsAny(passcode, "0123456789") && strings.IndexFunc(passcode, isLetter) >= 0) {
+			return passcode, nil
+		}
+	}
+}
+
+func isLetter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// profileEntry is one profile as profiles prints it.
+type profileEntry struct {
+	Name      string `json:"name"`
+	Alias     string `json:"alias,omitempty"`
+	Default   bool   `json:"default"`
+	GrantType string `json:"grant_type"`
+	AccountID string `json:"account_id,omitempty"`
+}
+
+// profilesResult is the output of profiles.
+type profilesResult []profileEntry
+
+func (r profilesResult) jsonValue() interface{} {
+	return []profileEntry(r)
+}
+
+func (r profilesResult) table() ([]string, [][]string) {
+	header := []string{"NAME", "ALIAS", "DEFAULT", "GRANT", "ACCOUNT ID"}
+	rows := make([][]string, len(r))
+	for i, p := range r {
+		isDefault := ""
+		if p.Default {
+			isDefault = "*"
+		}
+		rows[i] = []string{p.Name, p.Alias, isDefault, p.GrantType, p.AccountID}
+	}
+	return header, rows
+}
+
+// writePlain prints the profile names one per line, the default marked
+// with a "*".
+func (r profilesResult) writePlain(w io.Writer) {
+	for _, p := range r {
+		if p.Default {
+			fmt.Fprintln(w, p.Name, "*")
+		} else {
+			fmt.Fprintln(w, p.Name)
+		}
+	}
+}
+
+func runProfiles(_ context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting profiles", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	var format string
+	addFormatFlag(fs, &format, formatTable)
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	configFile, err := configPath(opts.Config)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+	file, err := readStoredConfig(configFile)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+	if file.Profiles == nil {
+		fmt.Println("The config file has no profiles, only a single account")
+		return
+	}
+
+	// A default_profile naming no profile is reported rather than listed
+	defaultName := ""
+	if file.DefaultProfile != "" {
+		defaultName, err = resolveProfile(file, "")
+		if err != nil {
+			fatalf(exitConfig, "Error loading config: %s: %v", configFile, err)
+		}
+	} else if err := checkAliases(file); err != nil {
+		fatalf(exitConfig, "Error loading config: %s: %v", configFile, err)
+	}
+
+	var entries profilesResult
+	for _, name := range profileNames(file) {
+		p := file.Profiles[name]
+		grant := p.GrantType
+		if grant == "" {
+			grant = zoom.GrantAccountCredentials
+		}
+		entries = append(entries, profileEntry{Name: name, Alias: p.Alias, Default: name == defaultName, GrantType: grant, AccountID: p.AccountID})
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(entries); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// stdin is shared by every prompt, so that answers piped in on several
+// lines are not lost in the buffer of an earlier reader.
+var stdin = bufio.NewReader(os.Stdin)
+
+// readLine reads one line from stdin without the line ending. It gives up
+// when ctx is cancelled while waiting.
+func readLine(ctx context.Context) (string, error) {
+	type result struct {
+		line string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		line, err := stdin.ReadString('\n')
+		if err != nil && line != "" {
+			err = nil
+		}
+		results <- result{strings.TrimRight(line, "\r\n"), err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.line, r.err
+	case <-ctx.Done():
+		fmt.Println()
+		return "", ctx.Err()
+	}
+}
+
+// prompt asks for a value on stdin.
+func prompt(ctx context.Context, label string) (string, error) {
+	fmt.Printf("%s: ", label)
+	line, err := readLine(ctx)
+	return strings.TrimSpace(line), err
+}
+
+// promptSecret asks for a value without echoing it when stdin is a
+// terminal. The terminal is restored if ctx is cancelled meanwhile.
+func promptSecret(ctx context.Context, label string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return prompt(ctx, label)
+	}
+
+	state, err := term.GetState(fd)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("%s: ", label)
+	type result struct {
+		secret []byte
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		secret, err := term.ReadPassword(fd)
+		results <- result{secret, err}
+	}()
+
+	select {
+	case r := <-results:
+		fmt.Println()
+		return strings.TrimSpace(string(r.secret)), r.err
+	case <-ctx.Done():
+		term.Restore(fd, state)
+		fmt.Println()
+		return "", ctx.Err()
+	}
+}
+
+// confirm asks a yes/no question on stdin, defaulting to no. It gives up
+// when ctx is cancelled while waiting for the answer.
+func confirm(ctx context.Context, question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+
+	answer, err := readLine(ctx)
+	if err != nil && answer == "" {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// qrLongURL is the length above which the QR code drops to low error
+// correction. Fewer modules keep the code small enough to fit a terminal
+// and scan reliably, which matters more here than damage tolerance.
+const qrLongURL = 120
+
+func qrRecoveryLevel(text string) qrcode.RecoveryLevel {
+	if len(text) > qrLongURL {
+		return qrcode.Low
+	}
+	return qrcode.Medium
+}
+
+// printQRCode renders text to w as a QR code made of Unicode half blocks.
+func printQRCode(w io.Writer, text string) error {
+	code, err := qrcode.New(text, qrRecoveryLevel(text))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(w, code.ToSmallString(false))
+	return err
+}
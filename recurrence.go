@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// buildRecurrence turns the --recur* flags into a Recurrence, reading
+// --recur-until in loc.
+func buildRecurrence(opts cliOptions, loc *time.Location) (*zoom.Recurrence, error) {
+	recurrence := &zoom.Recurrence{RepeatInterval: opts.RecurInterval}
+
+	switch opts.Recur {
+	case "daily":
+		recurrence.Type = zoom.RecurDaily
+	case "weekly":
+		recurrence.Type = zoom.RecurWeekly
+	case "monthly":
+		recurrence.Type = zoom.RecurMonthly
+	default:
+		return nil, fmt.Errorf("invalid --recur value %q: must be daily, weekly or monthly", opts.Recur)
+	}
+
+	if opts.RecurInterval < 1 {
+		return nil, fmt.Errorf("invalid --recur-interval %d: must be at least 1", opts.RecurInterval)
+	}
+
+	if opts.RecurDays != "" {
+		days, err := parseRecurDays(opts.RecurDays)
+		if err != nil {
+			return nil, err
+		}
+
+		switch recurrence.Type {
+		case zoom.RecurWeekly:
+			for _, day := range days {
+				if day < 1 || day > 7 {
+					return nil, fmt.Errorf("invalid --recur-days value %d: weekly days must be 1 (Sunday) to 7 (Saturday)", day)
+				}
+			}
+			recurrence.WeeklyDays = joinInts(days)
+		case zoom.RecurMonthly:
+			if len(days) != 1 || days[0] < 1 || days[0] > 31 {
+				return nil, fmt.Errorf("invalid --recur-days %q: monthly meetings take a single day of the month from 1 to 31", opts.RecurDays)
+			}
+			recurrence.MonthlyDay = days[0]
+		default:
+			return nil, errors.New("--recur-days only applies to weekly and monthly meetings")
+		}
+	}
+
+	switch {
+	case opts.RecurCount != 0 && opts.RecurUntil != "":
+		return nil, errors.New("--recur-count conflicts with --recur-until")
+	case opts.RecurCount != 0:
+		if opts.RecurCount < 1 {
+			return nil, fmt.Errorf("invalid --recur-count %d: must be positive", opts.RecurCount)
+		}
+		recurrence.EndTimes = opts.RecurCount
+	case opts.RecurUntil != "":
+		until, err := parseTime(opts.RecurUntil, time.Now().In(loc))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --recur-until: %w", err)
+		}
+		recurrence.EndDateTime = until.UTC().Format(time.RFC3339)
+	default:
+		return nil, errors.New("recurring meetings need an end: set --recur-count or --recur-until")
+	}
+
+	return recurrence, nil
+}
+
+func parseRecurDays(value string) ([]int, error) {
+	var days []int
+	for _, field := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --recur-days %q: must be a comma-separated list of numbers", value)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+func joinInts(values []int) string {
+	fields := make([]string, len(values))
+	for i, v := range values {
+		fields[i] = strconv.Itoa(v)
+	}
+	return strings.Join(fields, ",")
+}
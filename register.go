@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// languageTag matches the "en-US" shaped language tags Zoom takes; which
+// languages it supports is left for Zoom to decide.
+var languageTag = regexp.MustCompile(`^[A-Za-z]{2}-[A-Za-z]{2}$`)
+
+func validateLanguage(language string) error {
+	if !languageTag.MatchString(language) {
+		return fmt.Errorf("invalid language %q: use a tag such as en-US or de-DE", language)
+	}
+	return nil
+}
+
+// readRegistrants reads registrants from a CSV file whose header row names
+// the email, first_name, last_name and language columns, in any order.
+// last_name and language are optional and other columns are ignored.
+func readRegistrants(path string) ([]zoom.Registrant, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening registrants file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"email", "first_name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%s: header row has no %s column", path, required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var registrants []zoom.Registrant
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return registrants, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		registrant := zoom.Registrant{
+			Email:     field(record, "email"),
+			FirstName: field(record, "first_name"),
+			LastName:  field(record, "last_name"),
+			Language:  field(record, "language"),
+		}
+		if registrant.Email == "" || registrant.FirstName == "" {
+			return nil, fmt.Errorf("%s:%d: email and first_name are required", path, line)
+		}
+		if registrant.Language != "" {
+			if err := validateLanguage(registrant.Language); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+		}
+		registrants = append(registrants, registrant)
+	}
+}
+
+func runRegister(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting register", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	email := fs.String("email", "", "registrant's email address")
+	first := fs.String("first", "", "registrant's first name")
+	last := fs.String("last", "", "registrant's last name")
+	language := fs.String("language", "", `language of Zoom's emails to the registrants, e.g. "en-US" or "de-DE"; a language column in --registrants takes precedence`)
+	file := fs.String("registrants", "", "CSV file with email, first_name, last_name and language columns to register in one go")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting register [flags] <meeting-id>\n\nRegisters people for a meeting that requires registration. Flags:\n")
+		fs.PrintDefaults()
+	}
+
+	positional := parseArgs(fs, args)
+	if len(positional) != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if *language != "" {
+		if err := validateLanguage(*language); err != nil {
+			fatalf(exitConfig, "Error parsing flags: %v", err)
+		}
+	}
+	opts.apply()
+
+	id, err := normalizeMeetingID(positional[0])
+	if err != nil {
+		fatalf(exitConfig, "Error parsing arguments: %v", err)
+	}
+
+	var registrants []zoom.Registrant
+	if *file != "" {
+		registrants, err = readRegistrants(*file)
+		if err != nil {
+			fatalf(exitConfig, "Error reading registrants: %v", err)
+		}
+	}
+	if *email != "" || *first != "" || *last != "" {
+		if *email == "" || *first == "" {
+			fatalf(exitConfig, "Error parsing flags: --email and --first are both required")
+		}
+		registrants = append(registrants, zoom.Registrant{Email: *email, FirstName: *first, LastName: *last})
+	}
+	if len(registrants) == 0 {
+		fatalf(exitConfig, "Error parsing flags: give --email and --first, or --registrants")
+	}
+	for i := range registrants {
+		if registrants[i].Language == "" {
+			registrants[i].Language = *language
+		}
+	}
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	// Register everyone even if some fail, then report the failures
+	client := opts.newClient(config)
+	var errs []error
+	for _, registrant := range registrants {
+		joinURL, err := client.AddRegistrant(ctx, id, registrant)
+		if err != nil {
+			exitIfCancelled(ctx)
+			errs = append(errs, fmt.Errorf("%s: %w", registrant.Email, err))
+			continue
+		}
+		fmt.Printf("%s: %s\n", registrant.Email, joinURL)
+	}
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		fatalf(exitCodeFor(err), "Error registering %d of %d registrants:\n%v", len(errs), len(registrants), err)
+	}
+}
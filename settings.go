@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// applySettingsFlags overrides the settings with the explicitly set flags
+// and returns nil when nothing differs from Zoom's defaults, so that no
+// settings object is sent at all.
+func applySettingsFlags(opts cliOptions, base *zoom.MeetingSettings) *zoom.MeetingSettings {
+	var settings zoom.MeetingSettings
+	if base != nil {
+		settings = *base
+	}
+
+	if opts.isSet("host-video") {
+		settings.HostVideo = &opts.HostVideo
+	}
+	if opts.isSet("join-before-host") {
+		settings.JoinBeforeHost = &opts.JoinBeforeHost
+	}
+	if opts.isSet("jbh-time") {
+		on := true
+		settings.JoinBeforeHost = &on
+		settings.JBHTime = &opts.JBHTime
+	}
+	// Like the waiting room options, the window needs join before host
+	if settings.JoinBeforeHost != nil && !*settings.JoinBeforeHost {
+		settings.JBHTime = nil
+	}
+	if opts.isSet("mute-on-entry") {
+		settings.MuteUponEntry = &opts.MuteOnEntry
+	}
+	if opts.isSet("waiting-room") {
+		settings.WaitingRoom = &opts.WaitingRoom
+	}
+	if opts.WaitingRoomPolicy != "" {
+		on := true
+		who, _ := parseWaitingRoomPolicy(opts.WaitingRoomPolicy)
+		settings.WaitingRoom = &on
+		settings.WaitingRoomOptions = &zoom.WaitingRoomOptions{Mode: zoom.WaitingRoomCustom, Who: who}
+	}
+	// Options mean nothing without the waiting room, e.g. one a template
+	// turned off
+	if settings.WaitingRoom != nil && !*settings.WaitingRoom {
+		settings.WaitingRoomOptions = nil
+	}
+
+	if opts.isSet("alternative-hosts") {
+		settings.AlternativeHosts, _ = parseAlternativeHosts(opts.AlternativeHosts)
+	}
+
+	if opts.ContactName != "" {
+		settings.ContactName = opts.ContactName
+	}
+	if opts.ContactEmail != "" {
+		settings.ContactEmail = opts.ContactEmail
+	}
+
+	if opts.Recording != "" {
+		settings.AutoRecording = opts.Recording
+	}
+	if opts.Transcription {
+		settings.AutoRecording = zoom.RecordingCloud
+	}
+	if opts.Audio != "" {
+		settings.Audio = opts.Audio
+	}
+
+	// --approval alone also turns registration on, except for "none"
+	if opts.Register || opts.Approval != "" {
+		approval := zoom.ApprovalAutomatic
+		if opts.Approval != "" {
+			approval, _ = parseApproval(opts.Approval)
+		}
+		settings.ApprovalType = &approval
+	}
+
+	if reflect.ValueOf(settings).IsZero() {
+		return nil
+	}
+	return &settings
+}
+
+// parseAlternativeHosts checks the comma-separated emails given to
+// --alternative-hosts and joins them with semicolons, as Zoom expects.
+func parseAlternativeHosts(list string) (string, error) {
+	var hosts []string
+	for _, host := range strings.Split(list, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		if !isEmail(host) {
+			return "", fmt.Errorf("invalid --alternative-hosts entry %q: must be an email address", host)
+		}
+		hosts = append(hosts, host)
+	}
+	return strings.Join(hosts, ";"), nil
+}
+
+// isEmail reports whether s is a bare email address, without a display
+// name or angle brackets.
+func isEmail(s string) bool {
+	address, err := mail.ParseAddress(s)
+	return err == nil && address.Address == s
+}
+
+// parseApproval maps --approval to Zoom's approval_type.
+func parseApproval(value string) (int, error) {
+	switch value {
+	case "auto":
+		return zoom.ApprovalAutomatic, nil
+	case "manual":
+		return zoom.ApprovalManual, nil
+	case "none":
+		return zoom.ApprovalNoRegistration, nil
+	}
+	return 0, fmt.Errorf("invalid --approval value %q: must be auto, manual or none", value)
+}
+
+// parseWaitingRoomPolicy maps --waiting-room-policy to who Zoom holds in
+// the waiting room.
+func parseWaitingRoomPolicy(value string) (string, error) {
+	switch value {
+	case "everyone":
+		return zoom.WaitEveryone, nil
+	case "guests":
+		return zoom.WaitGuests, nil
+	}
+	return "", fmt.Errorf("invalid --waiting-room-policy value %q: must be everyone or guests", value)
+}
+
+// validateJBHTime checks --jbh-time against the windows Zoom offers.
+func validateJBHTime(minutes int) error {
+	switch minutes {
+	case 0, 5, 10:
+		return nil
+	}
+	return fmt.Errorf("invalid --jbh-time %d: must be 0 (any time), 5 or 10 minutes", minutes)
+}
+
+func validateRecording(value string) error {
+	switch value {
+	case zoom.RecordingNone, zoom.RecordingLocal, zoom.RecordingCloud:
+		return nil
+	}
+	return fmt.Errorf("invalid --recording value %q: must be cloud, local or none", value)
+}
+
+func validateAudio(value string) error {
+	switch value {
+	case zoom.AudioVoIP, zoom.AudioTelephony, zoom.AudioBoth, zoom.AudioThirdParty:
+		return nil
+	}
+	return fmt.Errorf("invalid --audio value %q: must be voip, telephony, both or thirdParty", value)
+}
+
+// requiresRegistration reports whether the settings turn registration on.
+func requiresRegistration(settings *zoom.MeetingSettings) bool {
+	return settings != nil && settings.ApprovalType != nil && *settings.ApprovalType != zoom.ApprovalNoRegistration
+}
+
+// checkTranscription makes sure the client's user can have cloud
+// recordings transcribed, which --transcription relies on.
+func checkTranscription(ctx context.Context, client *zoom.Client) error {
+	settings, err := client.UserSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch recording := settings.Recording; {
+	case recording.CloudRecording != nil && !*recording.CloudRecording:
+		return errors.New("cloud recording is turned off for the host; turn it on under Settings > Recording in the Zoom web portal")
+	case recording.AudioTranscript != nil && !*recording.AudioTranscript:
+		return errors.New(`audio transcripts are turned off for the host; turn on "Create audio transcript" under Settings > Recording > Cloud recording in the Zoom web portal`)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// exitCancelled is the conventional exit code for a program stopped by
+// SIGINT (128 + 2).
+const exitCancelled = 130
+
+// signalContext returns a context that is cancelled on SIGINT or SIGTERM,
+// aborting any request in flight. A second signal kills the process as
+// usual.
+func signalContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
+
+// exitIfCancelled ends the program when ctx was cancelled by a signal, so
+// that an interrupted request is not reported as a Zoom error.
+func exitIfCancelled(ctx context.Context) {
+	if ctx.Err() != nil {
+		fmt.Fprintln(os.Stderr, "cancelled")
+		os.Exit(exitCancelled)
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// defaultSlackTemplate is the Slack message when no --slack-template is
+// given, in Slack's mrkdwn.
+const defaultSlackTemplate = `*{{.Topic}}*{{if .StartTime}}
+Time: {{.StartTime}}{{if .Timezone}} ({{.Timezone}}){{end}}{{end}}
+{{if .JoinURL}}Join: {{.JoinURL}}{{else}}Meeting ID: {{.ID}}{{end}}{{if .Password}}
+Passcode: {{.Password}}{{end}}`
+
+// maxSlackErrorBody caps how much of a failed webhook response is quoted.
+const maxSlackErrorBody = 200
+
+// validateSlackWebhook checks a --slack-webhook URL, which must be https
+// since it carries the webhook's secret.
+func validateSlackWebhook(webhook string) error {
+	u, err := url.Parse(webhook)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return errors.New(`invalid --slack-webhook: must be an https URL such as "https://hooks.slack.com/services/..."`)
+	}
+	return nil
+}
+
+// parseSlackTemplate parses a --slack-template, or the default one for "",
+// and runs it once against an empty meeting, so that unknown fields are
+// reported before any request.
+func parseSlackTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = defaultSlackTemplate
+	}
+
+	tmpl, err := template.New("slack-template").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --slack-template: %w", err)
+	}
+	if err := tmpl.Execute(io.Discard, &zoom.Meeting{}); err != nil {
+		return nil, fmt.Errorf("invalid --slack-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// postToSlack posts the meeting to a Slack incoming webhook as the message
+// tmpl renders.
+func postToSlack(ctx context.Context, httpClient *http.Client, webhook string, tmpl *template.Template, meeting *zoom.Meeting) error {
+	var text strings.Builder
+	if err := tmpl.Execute(&text, meeting); err != nil {
+		return fmt.Errorf("rendering --slack-template: %w", err)
+	}
+
+	payload, err := json.Marshal(map[string]string{"text": text.String()})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// The webhook URL is a secret, so leave it out of the error
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("posting to Slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Slack answers "ok", or a short reason such as "invalid_token"
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxSlackErrorBody))
+		return fmt.Errorf("Slack responded with HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
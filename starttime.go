@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Absolute date-time layouts accepted by --start, tried in order.
+var startTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// Clock layouts accepted on their own or after "today"/"tomorrow".
+var clockLayouts = []string{
+	"15:04",
+	"3:04pm",
+	"3pm",
+}
+
+// zoomLocalTimeLayout is the wall-clock format Zoom expects for start_time
+// when the meeting also carries a timezone.
+const zoomLocalTimeLayout = "2006-01-02T15:04:05"
+
+// parseStartTime interprets a user supplied start time in loc. Times
+// without an explicit offset are wall-clock times in loc. An empty input
+// or "now" means the current time.
+func parseStartTime(input string, loc *time.Location) (time.Time, error) {
+	now := time.Now().In(loc)
+
+	start, err := parseTime(input, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if start.Before(now.Truncate(time.Minute)) {
+		logger.Warn("start time is in the past", "start_time", start.Format(time.RFC3339))
+	}
+
+	return start, nil
+}
+
+// formatStartTime renders start for the start_time field. With a timezone
+// Zoom wants the wall-clock time in that zone; without one the time is
+// sent with its UTC offset.
+func formatStartTime(start time.Time, timezone string, loc *time.Location) string {
+	if timezone == "" {
+		return start.Format(time.RFC3339)
+	}
+	return start.In(loc).Format(zoomLocalTimeLayout)
+}
+
+func parseTime(input string, now time.Time) (time.Time, error) {
+	value := strings.TrimSpace(input)
+	for _, layout := range startTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	value = strings.ToLower(value)
+	if value == "" || value == "now" {
+		return now, nil
+	}
+
+	day := now
+	if rest, ok := strings.CutPrefix(value, "today"); ok {
+		value = strings.TrimSpace(rest)
+	} else if rest, ok := strings.CutPrefix(value, "tomorrow"); ok {
+		day = now.AddDate(0, 0, 1)
+		value = strings.TrimSpace(rest)
+	}
+
+	if clock, ok := parseClock(value); ok {
+		year, month, date := day.Date()
+		return time.Date(year, month, date, clock.Hour(), clock.Minute(), 0, 0, now.Location()), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized start time %q: use RFC3339 (2006-01-02T15:04:05Z07:00), \"2006-01-02 15:04\", \"15:04\", \"9am\" or \"tomorrow 9am\"", input)
+}
+
+func parseClock(value string) (time.Time, bool) {
+	value = strings.ReplaceAll(value, " ", "")
+	for _, layout := range clockLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
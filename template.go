@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+	"gopkg.in/yaml.v3"
+)
+
+// loadMeetingTemplate reads meeting details from a JSON file, or a YAML
+// file when the extension is .yaml or .yml. Both use the same field names
+// as the Zoom API (topic, type, start_time, duration, ...). Fields the
+// template leaves out keep their values from base.
+func loadMeetingTemplate(path string, base zoom.MeetingDetails) (zoom.MeetingDetails, error) {
+	fileContent, err := os.ReadFile(path)
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		fileContent, err = yamlToJSON(fileContent)
+		if err != nil {
+			return zoom.MeetingDetails{}, fmt.Errorf("parsing %s: %w", path, err)
+		}
+	}
+
+	details := base
+	if err := json.Unmarshal(fileContent, &details); err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return details, nil
+}
+
+// readMeetingDetails reads meeting details as JSON from r, for --stdin,
+// with the same field names as a template. Fields left out keep their
+// values from base.
+func readMeetingDetails(r io.Reader, base zoom.MeetingDetails) (zoom.MeetingDetails, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("reading stdin: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return zoom.MeetingDetails{}, errors.New("no meeting details on stdin")
+	}
+
+	details := base
+	if err := json.Unmarshal(data, &details); err != nil {
+		return zoom.MeetingDetails{}, fmt.Errorf("parsing stdin: %w", describeJSONError(data, err))
+	}
+
+	return details, nil
+}
+
+// loadRawBody reads the --raw-body file, which must hold a JSON object.
+func loadRawBody(path string) (json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, describeJSONError(data, err))
+	}
+	if body == nil {
+		return nil, fmt.Errorf("parsing %s: the meeting must be a JSON object", path)
+	}
+
+	return json.RawMessage(data), nil
+}
+
+// yamlToJSON converts a YAML document to JSON so that templates in either
+// format decode through the struct's json tags.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(document)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// templatesResult is the output of templates.
+type templatesResult []zoom.MeetingTemplate
+
+func (r templatesResult) jsonValue() interface{} {
+	if r == nil {
+		return []zoom.MeetingTemplate{}
+	}
+	return []zoom.MeetingTemplate(r)
+}
+
+func (r templatesResult) table() ([]string, [][]string) {
+	header := []string{"ID", "NAME", "TYPE"}
+	rows := make([][]string, len(r))
+	for i, t := range r {
+		rows[i] = []string{t.ID, t.Name, templateTypeName(t.Type)}
+	}
+	return header, rows
+}
+
+// writePlain prints one template per line, the ID first.
+func (r templatesResult) writePlain(w io.Writer) {
+	for _, t := range r {
+		fmt.Fprintf(w, "%s %s\n", t.ID, t.Name)
+	}
+}
+
+func templateTypeName(t int) string {
+	switch t {
+	case 1:
+		return "meeting"
+	case 2:
+		return "admin"
+	}
+	return strconv.Itoa(t)
+}
+
+func runTemplates(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting templates", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	var format string
+	addFormatFlag(fs, &format, formatTable)
+	user := fs.String("user", "", "ID or email of the user whose templates to list (default the app's own user)")
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+	client.User = *user
+
+	templates, err := client.ListMeetingTemplates(ctx)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error listing meeting templates: %v", err)
+	}
+
+	if len(templates) == 0 && format != formatJSON {
+		fmt.Println("No meeting templates")
+		return
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(templatesResult(templates)); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+}
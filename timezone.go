@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// resolveTimezone returns the IANA name and location meetings are
+// scheduled in: the --timezone value if given, otherwise the system's
+// timezone. The name is empty when the system timezone has no IANA name
+// we can discover, in which case times are sent with an explicit offset.
+func resolveTimezone(name string) (string, *time.Location, error) {
+	if name == "" {
+		name = systemTimezone()
+		if name == "" {
+			return "", time.Local, nil
+		}
+	}
+
+	// "Local" loads fine but means nothing to Zoom
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return "", nil, fmt.Errorf("unknown timezone %q: use an IANA name such as America/New_York", name)
+	}
+
+	return name, loc, nil
+}
+
+// systemTimezone guesses the IANA name of the local timezone from $TZ or
+// the /etc/localtime symlink.
+func systemTimezone() string {
+	if tz := strings.TrimPrefix(os.Getenv("TZ"), ":"); tz != "" {
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+	}
+
+	target, err := filepath.EvalSymlinks("/etc/localtime")
+	if err != nil {
+		return ""
+	}
+
+	if _, name, ok := strings.Cut(target, "zoneinfo/"); ok {
+		if _, err := time.LoadLocation(name); err == nil {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// zoneinfoDirs are where the tz database is found, as in the time package.
+var zoneinfoDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// listTimezones returns the IANA timezone names in the system's tz
+// database, sorted, leaving out the posix/ and right/ copies and files
+// that are not zones.
+func listTimezones() ([]string, error) {
+	dirs := zoneinfoDirs
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		dirs = append([]string{dir}, dirs...)
+	}
+
+	for _, dir := range dirs {
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			continue
+		}
+		// WalkDir does not follow a symlinked root, and on macOS
+		// /usr/share/zoneinfo is one
+		dir, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			continue
+		}
+
+		var names []string
+		err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			name, _ := filepath.Rel(dir, path)
+			name = filepath.ToSlash(name)
+			if d.IsDir() {
+				if name == "posix" || name == "right" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if name == "localtime" || name == "posixrules" || name == "Factory" || !isTZif(path) {
+				return nil
+			}
+			names = append(names, name)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading tz database: %w", err)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	return nil, errors.New("no tz database found on this system; set ZONEINFO to its directory")
+}
+
+// isTZif reports whether the file at path is a compiled zone.
+func isTZif(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	magic := make([]byte, 4)
+	if _, err := f.Read(magic); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, []byte("TZif"))
+}
+
+// matchTimezone reports whether name contains filter, ignoring case and
+// treating spaces as the underscores IANA names use, so "new york" finds
+// America/New_York.
+func matchTimezone(name, filter string) bool {
+	filter = strings.ReplaceAll(strings.ToLower(filter), " ", "_")
+	return strings.Contains(strings.ToLower(name), filter)
+}
+
+func runTZ(_ context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting tz", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting tz [flags] [filter]\n\nLists the IANA timezone names --timezone accepts, those containing filter if given. Flags:\n")
+		fs.PrintDefaults()
+	}
+
+	positional := parseArgs(fs, args)
+	if len(positional) > 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	names, err := listTimezones()
+	if err != nil {
+		fatalf(exitConfig, "Error listing timezones: %v", err)
+	}
+
+	found := false
+	for _, name := range names {
+		if len(positional) == 0 || matchTimezone(name, positional[0]) {
+			fmt.Println(name)
+			found = true
+		}
+	}
+	switch {
+	case !found && len(positional) == 0:
+		fatalf(exitConfig, "Error listing timezones: the tz database holds no zones; set ZONEINFO to its directory")
+	case !found:
+		fatalf(exitConfig, "Error listing timezones: none matches %q", positional[0])
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// buildMeetingPatch returns the changes to send for update: only the
+// fields whose flags were given explicitly.
+func buildMeetingPatch(opts cliOptions) (zoom.MeetingDetails, error) {
+	var patch zoom.MeetingDetails
+
+	if opts.isSet("topic") {
+		if opts.Topic == "" {
+			return zoom.MeetingDetails{}, errors.New("--topic must not be empty")
+		}
+		patch.Topic = opts.Topic
+	}
+	if opts.isSet("duration") {
+		patch.Duration = opts.Duration
+	}
+	if opts.isSet("password") {
+		patch.Password = opts.Password
+	}
+	if opts.isSet("agenda") {
+		patch.Agenda = truncateAgenda(opts.Agenda)
+	}
+
+	// Without --timezone the meeting keeps its own, and a new start time
+	// is sent with its UTC offset
+	var name string
+	if opts.isSet("timezone") {
+		name = opts.Timezone
+	}
+	timezone, loc, err := resolveTimezone(name)
+	if err != nil {
+		return zoom.MeetingDetails{}, err
+	}
+	if opts.isSet("timezone") {
+		patch.Timezone = timezone
+	} else {
+		timezone = ""
+	}
+
+	if opts.isSet("start") {
+		startTime, err := parseStartTime(opts.Start, loc)
+		if err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+		patch.Start = formatStartTime(startTime, timezone, loc)
+
+		if opts.Until != "" {
+			duration, err := durationUntil(startTime, opts.Until)
+			if err != nil {
+				return zoom.MeetingDetails{}, err
+			}
+			patch.Duration = duration
+		}
+	} else if opts.Until != "" {
+		return zoom.MeetingDetails{}, errors.New("--until needs --start to compute the duration from")
+	}
+
+	if patch.Duration != 0 || opts.isSet("duration") {
+		if err := validateDuration(patch.Duration); err != nil {
+			return zoom.MeetingDetails{}, err
+		}
+	}
+
+	if patch == (zoom.MeetingDetails{}) {
+		return zoom.MeetingDetails{}, errors.New("nothing to update: give at least one of --topic, --start, --duration, --until, --timezone, --password or --agenda")
+	}
+
+	return patch, nil
+}
+
+func runUpdate(ctx context.Context, args []string) {
+	var opts cliOptions
+
+	fs, err := newFlagSet("zoom-meeting update", &opts.globalOptions)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting update [flags] <meeting-id>\n\nChanges only the given fields of a meeting. Flags:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&opts.Topic, "topic", "", "new meeting topic")
+	fs.IntVar(&opts.Duration, "duration", 0, "new meeting duration in minutes")
+	fs.StringVar(&opts.Start, "start", "", `new start time, e.g. "2025-06-01 14:30" or "tomorrow 9am"`)
+	fs.StringVar(&opts.Until, "until", "", `new end time, e.g. "15:30"; sets the duration from --start`)
+	fs.StringVar(&opts.Timezone, "timezone", "", `new IANA timezone, e.g. "America/New_York"; --start is read in it`)
+	fs.StringVar(&opts.Password, "password", "", "new meeting passcode")
+	fs.StringVar(&opts.Agenda, "agenda", "", "new meeting description, up to 2000 characters")
+
+	positional := parseArgs(fs, args)
+	if len(positional) != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	opts.set = map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		opts.set[f.Name] = true
+	})
+
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if opts.Until != "" && opts.isSet("duration") {
+		fatalf(exitConfig, "Error parsing flags: --until conflicts with --duration: give one or the other")
+	}
+	opts.apply()
+
+	id, err := normalizeMeetingID(positional[0])
+	if err != nil {
+		fatalf(exitConfig, "Error parsing arguments: %v", err)
+	}
+
+	patch, err := buildMeetingPatch(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error preparing update: %v", err)
+	}
+
+	config, err := loadOAuthConfig(opts.globalOptions)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	if err := opts.newClient(config).UpdateMeeting(ctx, id, patch); err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error updating meeting: %v", err)
+	}
+
+	fmt.Printf("Updated meeting %s\n", id)
+}
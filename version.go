@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// Build information, set with
+//
+//	go build -ldflags "-X main.version=1.2.0 -X main.commit=$(git rev-parse HEAD) -X main.date=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+var (
+	version = "dev"
+	commit  = ""
+	date    = ""
+)
+
+// versionString describes the build. Without -ldflags the commit and date
+// come from the VCS information go build embeds, when there is any.
+func versionString() string {
+	c, d := commit, date
+	if info, ok := debug.ReadBuildInfo(); ok && (c == "" || d == "") {
+		for _, setting := range info.Settings {
+			switch {
+			case setting.Key == "vcs.revision" && c == "":
+				c = setting.Value
+			case setting.Key == "vcs.time" && d == "":
+				d = setting.Value
+			}
+		}
+	}
+	if c == "" {
+		c = "unknown"
+	}
+	if d == "" {
+		d = "unknown"
+	}
+	return fmt.Sprintf("zoom-meeting %s (commit %s, built %s, %s %s/%s)", version, c, d, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+}
+
+func runVersion(_ context.Context, args []string) {
+	if len(args) > 0 {
+		fatalf(exitConfig, "Error parsing arguments: unexpected argument %q", args[0])
+	}
+	fmt.Println(versionString())
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// countdownInterval is how often --wait logs the time left, at debug level.
+const countdownInterval = time.Minute
+
+// waitForStart blocks until the meeting's start_time (as returned by Zoom,
+// in RFC3339) or until ctx is cancelled, logging a countdown with
+// --verbose. A start time in the past returns at once.
+func waitForStart(ctx context.Context, startTime string) error {
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		return fmt.Errorf("parsing start time %q: %w", startTime, err)
+	}
+
+	ticker := time.NewTicker(countdownInterval)
+	defer ticker.Stop()
+
+	timer := time.NewTimer(time.Until(start))
+	defer timer.Stop()
+
+	logger.Info("waiting for the meeting to start", "start_time", start.Local().Format(time.RFC3339))
+	for {
+		logger.Debug("meeting starts soon", "remaining", time.Until(start).Round(time.Second))
+
+		select {
+		case <-timer.C:
+			return nil
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// sleepContext waits for d, returning early with ctx's error if it is
+// cancelled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+const defaultWebinarTopic = "My Webinar"
+
+// webinarResult is the output of webinar.
+type webinarResult struct {
+	webinar      *zoom.Webinar
+	showStartURL bool
+}
+
+func (r webinarResult) jsonValue() interface{} {
+	return r.webinar
+}
+
+func (r webinarResult) table() ([]string, [][]string) {
+	w := r.webinar
+	return []string{"ID", "TOPIC", "START", "DURATION", "PASSCODE", "JOIN URL"},
+		[][]string{{strconv.FormatInt(w.ID, 10), w.Topic, w.StartTime, strconv.Itoa(w.Duration), w.Password, w.JoinURL}}
+}
+
+func (r webinarResult) writePlain(w io.Writer) {
+	fmt.Fprintln(w, "Webinar link:", paint(w, linkColor, r.webinar.JoinURL))
+	fmt.Fprintln(w, "Webinar ID:", r.webinar.ID)
+	if r.webinar.Password != "" {
+		fmt.Fprintln(w, "Passcode:", paint(w, passcodeColor, r.webinar.Password))
+	}
+	if r.showStartURL {
+		fmt.Fprintln(w, "Start URL:", paint(w, linkColor, r.webinar.StartURL))
+	}
+	if r.webinar.RegistrationURL != "" {
+		fmt.Fprintln(w, "Registration link:", paint(w, linkColor, r.webinar.RegistrationURL))
+	}
+}
+
+// buildWebinarDetails turns the webinar flags into a scheduled webinar,
+// resolving the start time and timezone as for meetings.
+func buildWebinarDetails(opts cliOptions) (zoom.WebinarDetails, error) {
+	if opts.Topic == "" {
+		return zoom.WebinarDetails{}, errors.New("--topic must not be empty")
+	}
+
+	details := zoom.WebinarDetails{
+		Topic:    opts.Topic,
+		Type:     zoom.TypeWebinar,
+		Password: opts.Password,
+		Agenda:   truncateAgenda(opts.Agenda),
+	}
+
+	timezone, loc, err := resolveTimezone(opts.Timezone)
+	if err != nil {
+		return zoom.WebinarDetails{}, err
+	}
+	startTime, err := parseStartTime(opts.Start, loc)
+	if err != nil {
+		return zoom.WebinarDetails{}, err
+	}
+	details.Start = formatStartTime(startTime, timezone, loc)
+	details.Timezone = timezone
+
+	details.Duration = opts.Duration
+	if opts.Until != "" {
+		details.Duration, err = durationUntil(startTime, opts.Until)
+		if err != nil {
+			return zoom.WebinarDetails{}, err
+		}
+	}
+	if err := validateDuration(details.Duration); err != nil {
+		return zoom.WebinarDetails{}, err
+	}
+
+	var settings zoom.WebinarSettings
+	if opts.isSet("host-video") {
+		settings.HostVideo = &opts.HostVideo
+	}
+	if opts.Register || opts.Approval != "" {
+		approval := zoom.ApprovalAutomatic
+		if opts.Approval != "" {
+			approval, _ = parseApproval(opts.Approval)
+		}
+		settings.ApprovalType = &approval
+	}
+	if opts.Recording != "" {
+		settings.AutoRecording = opts.Recording
+	}
+	if settings != (zoom.WebinarSettings{}) {
+		details.Settings = &settings
+	}
+
+	return details, nil
+}
+
+func runWebinar(ctx context.Context, args []string) {
+	var opts cliOptions
+
+	fs, err := newFlagSet("zoom-meeting webinar", &opts.globalOptions)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: zoom-meeting webinar [flags]\n\nSchedules a Zoom webinar; the account needs the webinar add-on. Flags:\n")
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&opts.Topic, "topic", defaultWebinarTopic, "webinar topic")
+	fs.StringVar(&opts.Start, "start", "", `webinar start time, e.g. "2025-06-01 14:30" or "tomorrow 9am" (default now)`)
+	fs.IntVar(&opts.Duration, "duration", defaultDuration, "webinar duration in minutes")
+	fs.StringVar(&opts.Until, "until", "", `webinar end time, e.g. "15:30"; sets the duration from the start time`)
+	fs.StringVar(&opts.Timezone, "timezone", "", `IANA timezone the webinar is scheduled in, e.g. "America/New_York" (default the system timezone)`)
+	fs.StringVar(&opts.Password, "password", "", "webinar passcode")
+	fs.StringVar(&opts.Agenda, "agenda", "", "webinar description shown to attendees, up to 2000 characters")
+	fs.StringVar(&opts.User, "user", "", "ID or email of the user to schedule the webinar for (default the app's own user)")
+	fs.BoolVar(&opts.HostVideo, "host-video", false, "start the webinar with the host's video on")
+	fs.BoolVar(&opts.Register, "register", false, "require attendees to register; prints the registration link")
+	fs.StringVar(&opts.Approval, "approval", "", "how registrations are approved: auto (default with --register), manual, or none for no registration")
+	fs.StringVar(&opts.Recording, "recording", "", "record the webinar automatically: cloud, local (on the host's computer) or none")
+	fs.BoolVar(&opts.NoCopy, "no-copy", false, "do not copy the webinar link to the clipboard")
+	fs.BoolVar(&opts.NoOpen, "no-open", false, "do not open the webinar link")
+	fs.BoolVar(&opts.ShowStartURL, "show-start-url", false, "also print the start URL, which lets anyone start the webinar as host")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "print the request that would be sent to Zoom and exit without creating the webinar")
+	addFormatFlag(fs, &opts.Format, formatPlain)
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+
+	opts.set = map[string]bool{}
+	fs.Visit(func(f *flag.Flag) {
+		opts.set[f.Name] = true
+	})
+
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(opts.Format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if opts.Until != "" && opts.isSet("duration") {
+		fatalf(exitConfig, "Error parsing flags: --until conflicts with --duration: give one or the other")
+	}
+	if opts.Approval != "" {
+		if _, err := parseApproval(opts.Approval); err != nil {
+			fatalf(exitConfig, "Error parsing flags: %v", err)
+		}
+		if opts.Register && opts.Approval == "none" {
+			fatalf(exitConfig, "Error parsing flags: --register conflicts with --approval none")
+		}
+	}
+	if opts.Recording != "" {
+		if err := validateRecording(opts.Recording); err != nil {
+			fatalf(exitConfig, "Error parsing flags: %v", err)
+		}
+	}
+	opts.apply()
+
+	details, err := buildWebinarDetails(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error preparing webinar: %v", err)
+	}
+
+	// As for meetings, a dry run needs no credentials
+	if opts.DryRun {
+		client := opts.newClient(zoom.OAuthConfig{})
+		client.User = opts.User
+		if err := printDryRun("POST", client.WebinarsURL(), details); err != nil {
+			fatalf(exitLocal, "Error printing request: %v", err)
+		}
+		return
+	}
+
+	config, err := loadOAuthConfig(opts.globalOptions)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+	client.User = opts.User
+
+	webinar, err := client.CreateWebinar(ctx, details)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error creating webinar: %v", err)
+	}
+
+	out := outputWriter{w: os.Stdout, format: opts.Format}
+	if err := out.write(webinarResult{webinar: webinar, showStartURL: opts.ShowStartURL}); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+
+	// As with meetings, the webinar exists by now, so a missing clipboard
+	// or browser is only worth a warning
+	var actions []postAction
+	if !opts.NoCopy {
+		actions = append(actions, postAction{
+			done:   "Copied to the clipboard",
+			failed: "could not copy to the clipboard",
+			run:    func() error { return copyToClipboard(webinar.JoinURL) },
+		})
+	}
+	if !opts.NoOpen && !opts.Quiet {
+		actions = append(actions, postAction{
+			done:   "Opened the webinar link",
+			failed: "could not open the webinar link",
+			run:    func() error { return openURL(webinar.JoinURL) },
+		})
+	}
+	runPostActions(actions)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/optiowl/zoom-meeting/zoom"
+)
+
+// userResult is the output of whoami.
+type userResult struct {
+	user *zoom.User
+}
+
+func (r userResult) jsonValue() interface{} {
+	return r.user
+}
+
+func (r userResult) table() ([]string, [][]string) {
+	return []string{"EMAIL", "NAME", "TYPE", "PLAN"}, [][]string{{r.user.Email, r.name(), r.user.TypeName(), r.plan()}}
+}
+
+func (r userResult) writePlain(w io.Writer) {
+	fmt.Fprintln(w, "Email:", r.user.Email)
+	if name := r.name(); name != "" {
+		fmt.Fprintln(w, "Name:", name)
+	}
+	fmt.Fprintln(w, "User type:", r.user.TypeName())
+	fmt.Fprintln(w, "Plan:", r.plan())
+	if r.user.RoleName != "" {
+		fmt.Fprintln(w, "Role:", r.user.RoleName)
+	}
+	if r.user.AccountID != "" {
+		fmt.Fprintln(w, "Account ID:", r.user.AccountID)
+	}
+}
+
+func (r userResult) name() string {
+	switch {
+	case r.user.FirstName == "":
+		return r.user.LastName
+	case r.user.LastName == "":
+		return r.user.FirstName
+	}
+	return r.user.FirstName + " " + r.user.LastName
+}
+
+// plan is the Zoom United plan if there is one, or what the user type
+// implies.
+func (r userResult) plan() string {
+	if r.user.PlanUnitedType != "" {
+		return "Zoom United " + r.user.PlanUnitedType
+	}
+	if r.user.Type == zoom.UserBasic {
+		return "free"
+	}
+	return "paid"
+}
+
+func runWhoami(ctx context.Context, args []string) {
+	var opts globalOptions
+
+	fs, err := newFlagSet("zoom-meeting whoami", &opts)
+	if err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	var format string
+	addFormatFlag(fs, &format, formatPlain)
+
+	if extra := parseArgs(fs, args); len(extra) > 0 {
+		fatalf(exitConfig, "Error parsing flags: unexpected argument %q", extra[0])
+	}
+	if err := opts.validate(); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	if err := validateFormat(format); err != nil {
+		fatalf(exitConfig, "Error parsing flags: %v", err)
+	}
+	opts.apply()
+
+	config, err := loadOAuthConfig(opts)
+	if err != nil {
+		fatalf(exitConfig, "Error loading config: %v", err)
+	}
+
+	client := opts.newClient(config)
+	user, err := client.CurrentUser(ctx)
+	if err != nil {
+		exitIfCancelled(ctx)
+		fatalf(exitCodeFor(err), "Error checking credentials: %v", err)
+	}
+
+	out := outputWriter{w: os.Stdout, format: format}
+	if err := out.write(userResult{user: user}); err != nil {
+		fatalf(exitLocal, "Error writing output: %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+package zoom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError is a non-2xx response from Zoom that does not reject the
+// credentials: any error from the REST API other than HTTP 401, and rate
+// limiting or a server error from the OAuth token endpoint.
+type APIError struct {
+	// Service is "API" for the REST API or "OAuth" for the token
+	// endpoint; empty means "API".
+	Service    string
+	StatusCode int
+	// ZoomCode is Zoom's own error code, when the body carried one.
+	ZoomCode int
+	Message  string
+	// TrackingID identifies the request to Zoom support.
+	TrackingID string
+}
+
+func (e *APIError) Error() string {
+	service := e.Service
+	if service == "" {
+		service = "API"
+	}
+	return formatResponseError(service, e.StatusCode, e.Message, e.TrackingID)
+}
+
+// AuthError is a rejection of the credentials: a 4xx response from the
+// OAuth token endpoint other than 429, or an HTTP 401 from the API when
+// the token lacks the needed scopes.
+type AuthError struct {
+	// Service is "OAuth" for the token endpoint or "API" for the REST API.
+	Service    string
+	StatusCode int
+	// ZoomCode is Zoom's own error code, when the body carried one.
+	ZoomCode   int
+	Message    string
+	TrackingID string
+}
+
+func (e *AuthError) Error() string {
+	return formatResponseError(e.Service, e.StatusCode, e.Message, e.TrackingID)
+}
+
+// ConfigError is an OAuthConfig that cannot work, found before any
+// request is sent.
+type ConfigError struct {
+	// Field is the config field at fault, e.g. "grant_type".
+	Field   string
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	return e.Message
+}
+
+func formatResponseError(service string, statusCode int, message, trackingID string) string {
+	// Errors found after a successful response carry no status
+	if statusCode == 0 {
+		return fmt.Sprintf("zoom %s error: %s", service, message)
+	}
+	if trackingID != "" {
+		return fmt.Sprintf("zoom %s error %d: %s (tracking ID %s)", service, statusCode, message, trackingID)
+	}
+	return fmt.Sprintf("zoom %s error %d: %s", service, statusCode, message)
+}
+
+// IsAuthError reports whether err is an *AuthError, i.e. the credentials
+// are wrong or lack the needed scopes.
+func IsAuthError(err error) bool {
+	var authErr *AuthError
+	return errors.As(err, &authErr)
+}
+
+// IsAPIError reports whether err is an error response from Zoom, an
+// *APIError or an *AuthError, as opposed to a failure to reach it.
+func IsAPIError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) || IsAuthError(err)
+}
+
+// statusCode returns the HTTP status of the error response in err's
+// chain, or 0 if there is none.
+func statusCode(err error) int {
+	var apiErr *APIError
+	var authErr *AuthError
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode
+	case errors.As(err, &authErr):
+		return authErr.StatusCode
+	}
+	return 0
+}
+
+// timeoutError is a request that ran past the HTTP client's timeout.
+type timeoutError struct {
+	timeout time.Duration
+	err     error
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("request to Zoom timed out after %s", e.timeout)
+}
+
+func (e *timeoutError) Unwrap() error {
+	return e.err
+}
+
+// describedError gives err a clearer message while keeping it in the
+// chain for errors.As.
+type describedError struct {
+	message string
+	err     error
+}
+
+func (e *describedError) Error() string {
+	return e.message
+}
+
+func (e *describedError) Unwrap() error {
+	return e.err
+}
+
+// checkResponse returns an *APIError or *AuthError describing resp if its
+// status is not 2xx. The REST API reports errors as {"code", "message"}
+// while the OAuth endpoint uses {"reason", "error"}; both shapes are
+// understood.
+func checkResponse(service string, resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var errorBody struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Reason  string `json:"reason"`
+		Error   string `json:"error"`
+	}
+	json.Unmarshal(body, &errorBody)
+
+	message := errorBody.Message
+	if message == "" {
+		message = errorBody.Reason
+	}
+	if message == "" {
+		message = errorBody.Error
+	}
+	if message == "" {
+		message = strings.TrimSpace(string(body))
+	}
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+
+	// An OAuth outage or rate limit says nothing about the credentials
+	rejected := resp.StatusCode == http.StatusUnauthorized
+	if service == "OAuth" {
+		rejected = resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests
+	}
+	if rejected {
+		return &AuthError{
+			Service:    service,
+			StatusCode: resp.StatusCode,
+			ZoomCode:   errorBody.Code,
+			Message:    Redact(message),
+			TrackingID: trackingID(resp),
+		}
+	}
+	return &APIError{
+		Service:    service,
+		StatusCode: resp.StatusCode,
+		ZoomCode:   errorBody.Code,
+		Message:    Redact(message),
+		TrackingID: trackingID(resp),
+	}
+}
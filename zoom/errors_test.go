@@ -0,0 +1,134 @@
+package zoom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIUnauthorizedIsAuthError(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(trackingIDHeader, "tracking-401")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"code": 4711, "message": "Invalid access token, does not contain scopes:[meeting:read]."}`))
+	})
+
+	_, err := f.client().GetMeeting(context.Background(), "85746065432")
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("GetMeeting error = %v (%T), want an *AuthError", err, err)
+	}
+	if authErr.Service != "API" || authErr.StatusCode != http.StatusUnauthorized || authErr.ZoomCode != 4711 {
+		t.Errorf("AuthError = %+v, want Service API, StatusCode 401, ZoomCode 4711", authErr)
+	}
+	if authErr.TrackingID != "tracking-401" {
+		t.Errorf("TrackingID = %q, want tracking-401", authErr.TrackingID)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("errors.As(%v, *APIError) = true, want false for a 401", err)
+	}
+}
+
+func TestOAuthRejectionIsAuthError(t *testing.T) {
+	f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"reason": "Invalid client_id or client_secret", "error": "invalid_client"}`))
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API was called without a token")
+	})
+
+	_, err := f.client().GetMeeting(context.Background(), "85746065432")
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("GetMeeting error = %v (%T), want an *AuthError", err, err)
+	}
+	if authErr.Service != "OAuth" || authErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("AuthError = %+v, want Service OAuth, StatusCode 401", authErr)
+	}
+}
+
+func TestNotFoundIsAPIError(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(trackingIDHeader, "tracking-404")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code": 3001, "message": "Meeting does not exist: 85746065432."}`))
+	})
+
+	_, err := f.client().GetMeeting(context.Background(), "85746065432")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetMeeting error = %v (%T), want an *APIError", err, err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.ZoomCode != 3001 || apiErr.TrackingID != "tracking-404" {
+		t.Errorf("APIError = %+v, want StatusCode 404, ZoomCode 3001, TrackingID tracking-404", apiErr)
+	}
+	if IsAuthError(err) {
+		t.Errorf("IsAuthError(%v) = true, want false for a 404", err)
+	}
+}
+
+func TestMissingAccountIDIsConfigError(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API was called without a token")
+	})
+	client := f.client()
+	client.Config.AccountID = ""
+
+	_, err := client.GetMeeting(context.Background(), "85746065432")
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("GetMeeting error = %v (%T), want a *ConfigError", err, err)
+	}
+	if configErr.Field != "account_id" {
+		t.Errorf("Field = %q, want account_id", configErr.Field)
+	}
+	if n := f.tokenRequests.Load(); n != 0 {
+		t.Errorf("token requests = %d, want none for a bad config", n)
+	}
+	if IsAPIError(err) {
+		t.Errorf("IsAPIError(%v) = true, want false", err)
+	}
+}
+
+func TestOAuthOutageIsAPIError(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		status := status
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+				w.Write([]byte(`{"reason": "try again later"}`))
+			}, func(w http.ResponseWriter, r *http.Request) {
+				t.Error("the API was called without a token")
+			})
+
+			_, err := f.client().GetMeeting(context.Background(), "85746065432")
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("GetMeeting error = %v (%T), want an *APIError", err, err)
+			}
+			if apiErr.Service != "OAuth" || apiErr.StatusCode != status {
+				t.Errorf("APIError = %+v, want Service OAuth, StatusCode %d", apiErr, status)
+			}
+			if IsAuthError(err) {
+				t.Errorf("IsAuthError(%v) = true, want false: the credentials were not rejected", err)
+			}
+		})
+	}
+}
+
+func TestOAuthBadRequestIsAuthError(t *testing.T) {
+	f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"reason": "Invalid authorization code", "error": "invalid_request"}`))
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API was called without a token")
+	})
+
+	_, err := f.client().GetMeeting(context.Background(), "85746065432")
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || authErr.Service != "OAuth" || authErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("GetMeeting error = %v (%T), want an OAuth *AuthError with status 400", err, err)
+	}
+}
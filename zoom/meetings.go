@@ -0,0 +1,533 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Meeting types.
+const (
+	TypeInstant            = 1
+	TypeScheduled          = 2
+	TypeRecurringNoFixed   = 3
+	TypeRecurringFixedTime = 8
+
+	// TypePMI is how Zoom reports a meeting held with the host's Personal
+	// Meeting ID. It cannot be created as such: CreateMeeting sends it as
+	// a scheduled meeting with MeetingSettings.UsePMI on.
+	TypePMI = 4
+)
+
+// Recurrence types.
+const (
+	RecurDaily   = 1
+	RecurWeekly  = 2
+	RecurMonthly = 3
+)
+
+// Approval types of a meeting's registration.
+const (
+	ApprovalAutomatic      = 0
+	ApprovalManual         = 1
+	ApprovalNoRegistration = 2
+)
+
+// listPageSize is the largest page Zoom allows when listing meetings.
+const listPageSize = 300
+
+// MeetingDetails holds information about the meeting. Empty fields are
+// left out, so the same type serves as a partial update for UpdateMeeting.
+type MeetingDetails struct {
+	Topic    string `json:"topic,omitempty"`
+	Type     int    `json:"type,omitempty"`
+	Start    string `json:"start_time,omitempty"`
+	Duration int    `json:"duration,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+	Password string `json:"password,omitempty"`
+	Agenda   string `json:"agenda,omitempty"`
+
+	// TemplateID creates the meeting from a template saved in the Zoom
+	// web portal, see ListMeetingTemplates.
+	TemplateID string `json:"template_id,omitempty"`
+
+	// PreSchedule creates a scheduled meeting with no start time yet, to
+	// be set later; Zoom gives it no join URL until then.
+	PreSchedule bool `json:"pre_schedule,omitempty"`
+
+	// Recurrence is only sent for recurring meetings with a fixed time (type 8).
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+
+	// Settings is only sent when at least one setting is chosen.
+	Settings *MeetingSettings `json:"settings,omitempty"`
+}
+
+// withoutSchedule returns d with the fields that place a meeting in time
+// cleared, so that omitempty drops them from the payload.
+func (d MeetingDetails) withoutSchedule() MeetingDetails {
+	d.Start = ""
+	d.Duration = 0
+	d.Timezone = ""
+	d.Recurrence = nil
+	return d
+}
+
+// WithPMI returns d as Zoom takes a TypePMI meeting: scheduled, with
+// UsePMI on. Settings are copied rather than changed in place.
+func (d MeetingDetails) WithPMI() MeetingDetails {
+	d.Type = TypeScheduled
+
+	settings := MeetingSettings{}
+	if d.Settings != nil {
+		settings = *d.Settings
+	}
+	on := true
+	settings.UsePMI = &on
+	d.Settings = &settings
+	return d
+}
+
+// Recurrence describes the repeat pattern of a recurring meeting with a
+// fixed time (type 8). Exactly one of EndTimes and EndDateTime is set.
+type Recurrence struct {
+	Type           int    `json:"type"`
+	RepeatInterval int    `json:"repeat_interval,omitempty"`
+	WeeklyDays     string `json:"weekly_days,omitempty"`
+	MonthlyDay     int    `json:"monthly_day,omitempty"`
+	EndTimes       int    `json:"end_times,omitempty"`
+	EndDateTime    string `json:"end_date_time,omitempty"`
+}
+
+// MeetingSettings holds the optional settings object of a meeting. A nil
+// field is left out so that Zoom applies the host's own meeting settings
+// from the web portal. For a new account those are: host video off, join
+// before host off, mute upon entry off and waiting room on.
+type MeetingSettings struct {
+	HostVideo      *bool `json:"host_video,omitempty"`
+	JoinBeforeHost *bool `json:"join_before_host,omitempty"`
+	MuteUponEntry  *bool `json:"mute_upon_entry,omitempty"`
+	WaitingRoom    *bool `json:"waiting_room,omitempty"`
+
+	// UsePMI holds the meeting in the host's personal meeting room, with
+	// its Personal Meeting ID, instead of a new ID.
+	UsePMI *bool `json:"use_pmi,omitempty"`
+
+	// JBHTime is how many minutes before the start participants may join,
+	// 5 or 10, or 0 for any time; it only applies with JoinBeforeHost on.
+	JBHTime *int `json:"jbh_time,omitempty"`
+
+	// WaitingRoomOptions chooses who waits; it only applies with the
+	// waiting room on.
+	WaitingRoomOptions *WaitingRoomOptions `json:"waiting_room_options,omitempty"`
+
+	// AlternativeHosts lists the emails of users who may start the
+	// meeting, separated by semicolons.
+	AlternativeHosts string `json:"alternative_hosts,omitempty"`
+
+	// ContactName and ContactEmail name who registrants can reach about
+	// the meeting; they appear on registration confirmations.
+	ContactName  string `json:"contact_name,omitempty"`
+	ContactEmail string `json:"contact_email,omitempty"`
+
+	// ApprovalType turns on registration when set to ApprovalAutomatic or
+	// ApprovalManual. RegistrationType applies to recurring meetings with
+	// registration: 1 register once for all occurrences, 2 for each
+	// occurrence, 3 once for chosen occurrences.
+	ApprovalType     *int `json:"approval_type,omitempty"`
+	RegistrationType *int `json:"registration_type,omitempty"`
+
+	// AutoRecording is RecordingNone, RecordingLocal or RecordingCloud.
+	AutoRecording string `json:"auto_recording,omitempty"`
+
+	// Audio is the audio participants may join with: AudioVoIP,
+	// AudioTelephony, AudioBoth or AudioThirdParty.
+	Audio string `json:"audio,omitempty"`
+
+	// BreakoutRoom pre-assigns participants to breakout rooms.
+	BreakoutRoom *BreakoutRooms `json:"breakout_room,omitempty"`
+
+	// GlobalDialInNumbers is filled in by Zoom in responses; it is empty
+	// when the account has no dial-in numbers.
+	GlobalDialInNumbers []DialInNumber `json:"global_dial_in_numbers,omitempty"`
+}
+
+// Values of MeetingSettings.AutoRecording.
+const (
+	RecordingNone  = "none"
+	RecordingLocal = "local"
+	RecordingCloud = "cloud"
+)
+
+// Values of MeetingSettings.Audio.
+const (
+	AudioVoIP       = "voip"
+	AudioTelephony  = "telephony"
+	AudioBoth       = "both"
+	AudioThirdParty = "thirdParty"
+)
+
+// WaitingRoomOptions overrides the account's waiting room setting for one
+// meeting when Mode is WaitingRoomCustom.
+type WaitingRoomOptions struct {
+	Mode string `json:"mode"`
+	// Who is WaitEveryone or WaitGuests.
+	Who string `json:"who_goes_to_waiting_room,omitempty"`
+}
+
+// Values of WaitingRoomOptions.
+const (
+	WaitingRoomFollowSetting = "follow_setting"
+	WaitingRoomCustom        = "custom"
+
+	WaitEveryone = "everyone"
+	WaitGuests   = "users_not_in_account"
+)
+
+// BreakoutRooms is the breakout_room setting of a meeting.
+type BreakoutRooms struct {
+	Enable bool           `json:"enable"`
+	Rooms  []BreakoutRoom `json:"rooms,omitempty"`
+}
+
+// BreakoutRoom is a breakout room and the emails of the participants
+// assigned to it.
+type BreakoutRoom struct {
+	Name         string   `json:"name"`
+	Participants []string `json:"participants"`
+}
+
+// DialInNumber is a phone number participants can call to join.
+type DialInNumber struct {
+	Country     string `json:"country"`
+	CountryName string `json:"country_name,omitempty"`
+	City        string `json:"city,omitempty"`
+	Number      string `json:"number"`
+	Type        string `json:"type,omitempty"`
+}
+
+// Meeting is a meeting as returned by the Zoom API.
+type Meeting struct {
+	ID        int64  `json:"id"`
+	Topic     string `json:"topic"`
+	Type      int    `json:"type"`
+	StartTime string `json:"start_time,omitempty"`
+	Duration  int    `json:"duration,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	Agenda    string `json:"agenda,omitempty"`
+	Password  string `json:"password,omitempty"`
+	JoinURL   string `json:"join_url"`
+	StartURL  string `json:"start_url,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// PSTNPassword is the numeric passcode for joining by phone.
+	PSTNPassword string `json:"pstn_password,omitempty"`
+
+	// PreSchedule is set for meetings created with no start time, which
+	// have no JoinURL.
+	PreSchedule bool `json:"pre_schedule,omitempty"`
+
+	// TrackingID is the ID of the request that created the meeting, for
+	// Zoom support; it is not part of Zoom's meeting object.
+	TrackingID string `json:"tracking_id,omitempty"`
+
+	// RegistrationURL is only set for meetings that require registration.
+	RegistrationURL string `json:"registration_url,omitempty"`
+
+	Settings *MeetingSettings `json:"settings,omitempty"`
+}
+
+// DialInNumbers returns the meeting's dial-in numbers, if any.
+func (m *Meeting) DialInNumbers() []DialInNumber {
+	if m.Settings == nil {
+		return nil
+	}
+	return m.Settings.GlobalDialInNumbers
+}
+
+// Registrant is a person registering for a meeting.
+type Registrant struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name,omitempty"`
+	// Language is the language of Zoom's emails to the registrant, such
+	// as "en-US" or "de-DE".
+	Language string `json:"language,omitempty"`
+}
+
+// meetingList is one page of the list meetings response.
+type meetingList struct {
+	NextPageToken string    `json:"next_page_token"`
+	Meetings      []Meeting `json:"meetings"`
+}
+
+// MeetingsURL returns the endpoint meetings are created at and listed from
+// for the client's user.
+func (c *Client) MeetingsURL() string {
+	return c.meetingsURLForUser(c.User)
+}
+
+// meetingsURLForUser returns the meetings endpoint of a user given by ID or
+// email address; an empty userID means the app's own user, "me".
+func (c *Client) meetingsURLForUser(userID string) string {
+	return c.userURL(userID) + "/meetings"
+}
+
+// userURL returns the API URL of a user, "me" when userID is empty.
+func (c *Client) userURL(userID string) string {
+	if userID == "" {
+		userID = "me"
+	}
+	return c.baseURL() + "/users/" + url.PathEscape(userID)
+}
+
+// meetingURL returns the API URL of a single meeting.
+func (c *Client) meetingURL(id string) string {
+	return c.baseURL() + "/meetings/" + url.PathEscape(id)
+}
+
+// CreateMeeting creates a meeting from details. For instant meetings and
+// recurring meetings with no fixed time the start time, duration, timezone
+// and recurrence are never sent, whatever details holds, since some
+// accounts reject such meetings carrying them.
+//
+// Only rate-limited attempts are retried unless c.RetryCreate is set, as a
+// retry after Zoom created the meeting would create it twice.
+func (c *Client) CreateMeeting(ctx context.Context, details MeetingDetails) (*Meeting, error) {
+	if details.Type == TypeInstant || details.Type == TypeRecurringNoFixed {
+		details = details.withoutSchedule()
+	}
+	if details.Type == TypePMI {
+		details = details.WithPMI()
+	}
+
+	sentAt := time.Now()
+	data, tracking, err := c.callAPIRetrying(ctx, "POST", c.MeetingsURL(), details, isRetryableCreate)
+	for attempt := 0; err != nil && c.RetryCreate && attempt < c.Retries; attempt++ {
+		reached := mayHaveCreated(ctx, err)
+		if !reached && !neverSent(ctx, err) {
+			break
+		}
+		if err := sleep(ctx, initialRetryDelay<<attempt); err != nil {
+			return nil, err
+		}
+
+		// Only a request that reached Zoom can have created the meeting
+		if reached {
+			existing, findErr := c.findCreatedMeeting(ctx, details, sentAt)
+			if findErr != nil {
+				c.logger().Debug("could not look for the meeting before retrying", "error", findErr)
+			}
+			if existing != nil {
+				c.logger().Info("the meeting was created despite the error", "id", existing.ID, "error", err)
+				return existing, nil
+			}
+		}
+
+		c.logger().Info("creating the meeting failed, retrying", "error", err, "attempt", attempt+1, "retries", c.Retries)
+		data, tracking, err = c.callAPIRetrying(ctx, "POST", c.MeetingsURL(), details, isRetryableCreate)
+	}
+	if err != nil {
+		return nil, c.userNotFound(err)
+	}
+
+	var meeting Meeting
+	if err := json.Unmarshal(data, &meeting); err != nil {
+		return nil, fmt.Errorf("decoding meeting: %w", err)
+	}
+	meeting.TrackingID = tracking
+
+	return &meeting, nil
+}
+
+// CreateMeetingRaw creates a meeting from body, a JSON object sent as the
+// request body as it is, for fields MeetingDetails does not have. Only
+// rate-limited attempts are retried, since without the details there is
+// no looking for a meeting created despite an error.
+func (c *Client) CreateMeetingRaw(ctx context.Context, body json.RawMessage) (*Meeting, error) {
+	data, tracking, err := c.callAPIRetrying(ctx, "POST", c.MeetingsURL(), body, isRetryableCreate)
+	if err != nil {
+		return nil, c.userNotFound(err)
+	}
+
+	var meeting Meeting
+	if err := json.Unmarshal(data, &meeting); err != nil {
+		return nil, fmt.Errorf("decoding meeting: %w", err)
+	}
+	meeting.TrackingID = tracking
+
+	return &meeting, nil
+}
+
+// mayHaveCreated reports whether a failed create could have reached Zoom
+// and succeeded there: a server error or a request whose response was
+// lost. Errors Zoom answered with, or before sending, rule that out.
+func mayHaveCreated(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		// A token request failing means the create was never sent
+		return apiErr.Service != "OAuth" && apiErr.StatusCode >= 500
+	}
+	if IsAuthError(err) || neverSent(ctx, err) {
+		return false
+	}
+	var urlErr *url.Error
+	var timeoutErr *timeoutError
+	return errors.As(err, &urlErr) || errors.As(err, &timeoutErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// neverSent reports whether a request failed before it was written: the
+// host could not be resolved, or no connection to it or to the proxy
+// could be made. Such a request is safe to send again.
+func neverSent(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	return errors.As(err, &dnsErr) || errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "proxyconnect")
+}
+
+// findCreatedMeeting looks among the user's meetings for one created from
+// details since sentAt, allowing a minute of clock skew, and returns it in
+// full. It returns nil when there is none; instant meetings are never
+// listed, so they are not found.
+func (c *Client) findCreatedMeeting(ctx context.Context, details MeetingDetails, sentAt time.Time) (*Meeting, error) {
+	meetings, err := c.ListMeetings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range meetings {
+		createdAt, err := time.Parse(time.RFC3339, m.CreatedAt)
+		if err != nil || createdAt.Before(sentAt.Add(-time.Minute)) {
+			continue
+		}
+		if m.Topic == details.Topic && listedAs(details, m.Type) {
+			return c.GetMeeting(ctx, strconv.FormatInt(m.ID, 10))
+		}
+	}
+	return nil, nil
+}
+
+// listedAs reports whether a meeting created from details can be listed
+// with meetingType. A meeting created with UsePMI on is sent as scheduled
+// but listed as TypePMI.
+func listedAs(details MeetingDetails, meetingType int) bool {
+	switch {
+	case details.Type == 0 || meetingType == details.Type:
+		return true
+	case details.Settings != nil && details.Settings.UsePMI != nil && *details.Settings.UsePMI:
+		return meetingType == TypePMI
+	}
+	return false
+}
+
+// ListMeetings returns all upcoming scheduled meetings, following
+// next_page_token until every page has been fetched.
+func (c *Client) ListMeetings(ctx context.Context) ([]Meeting, error) {
+	var meetings []Meeting
+
+	pageToken := ""
+	for {
+		query := url.Values{}
+		query.Set("type", "scheduled")
+		query.Set("page_size", fmt.Sprint(listPageSize))
+		if pageToken != "" {
+			query.Set("next_page_token", pageToken)
+		}
+
+		data, err := c.callAPI(ctx, "GET", c.MeetingsURL()+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, c.userNotFound(err)
+		}
+
+		var page meetingList
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("decoding meeting list: %w", err)
+		}
+		meetings = append(meetings, page.Meetings...)
+
+		if page.NextPageToken == "" {
+			return meetings, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// GetMeeting returns the meeting with the given ID.
+func (c *Client) GetMeeting(ctx context.Context, id string) (*Meeting, error) {
+	data, err := c.callAPI(ctx, "GET", c.meetingURL(id), nil)
+	if err != nil {
+		return nil, notFound(err, id)
+	}
+
+	var meeting Meeting
+	if err := json.Unmarshal(data, &meeting); err != nil {
+		return nil, fmt.Errorf("decoding meeting: %w", err)
+	}
+
+	return &meeting, nil
+}
+
+// UpdateMeeting changes the fields of the meeting that are set in patch.
+// Zoom answers 204 with no body on success.
+func (c *Client) UpdateMeeting(ctx context.Context, id string, patch MeetingDetails) error {
+	if _, err := c.callAPI(ctx, "PATCH", c.meetingURL(id), patch); err != nil {
+		return notFound(err, id)
+	}
+	return nil
+}
+
+// AddRegistrant registers r for the meeting and returns the join URL Zoom
+// generates for that registrant alone.
+func (c *Client) AddRegistrant(ctx context.Context, id string, r Registrant) (string, error) {
+	data, err := c.callAPI(ctx, "POST", c.meetingURL(id)+"/registrants", r)
+	if err != nil {
+		return "", notFound(err, id)
+	}
+
+	var registered struct {
+		JoinURL string `json:"join_url"`
+	}
+	if err := json.Unmarshal(data, &registered); err != nil {
+		return "", fmt.Errorf("decoding registrant: %w", err)
+	}
+
+	return registered.JoinURL, nil
+}
+
+// DeleteMeeting deletes the meeting; Zoom answers 204 on success and 404
+// when there is no such meeting.
+func (c *Client) DeleteMeeting(ctx context.Context, id string) error {
+	if _, err := c.callAPI(ctx, "DELETE", c.meetingURL(id), nil); err != nil {
+		return notFound(err, id)
+	}
+	return nil
+}
+
+// userNotFound translates the 404 Zoom returns for an unknown user into a
+// message naming the user.
+func (c *Client) userNotFound(err error) error {
+	if c.User != "" && statusCode(err) == http.StatusNotFound {
+		return fmt.Errorf("user %s does not exist in the account or cannot be managed by this app: %w", c.User, err)
+	}
+	return err
+}
+
+// notFound translates a 404 from Zoom into a message naming the meeting.
+func notFound(err error, id string) error {
+	if statusCode(err) == http.StatusNotFound {
+		return &describedError{message: fmt.Sprintf("meeting %s does not exist", id), err: err}
+	}
+	return err
+}
@@ -0,0 +1,84 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// createdPayload creates a meeting from details against a fakeZoom and
+// returns the JSON object the client sent.
+func createdPayload(t *testing.T, details MeetingDetails) map[string]interface{} {
+	t.Helper()
+
+	var payload map[string]interface{}
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1, "join_url": "https://zoom.us/j/1"}`))
+	})
+
+	if _, err := f.client().CreateMeeting(context.Background(), details); err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	return payload
+}
+
+func payloadKeys(payload map[string]interface{}) []string {
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestCreateInstantMeetingPayload(t *testing.T) {
+	payload := createdPayload(t, MeetingDetails{
+		Topic:      "Quick sync",
+		Type:       TypeInstant,
+		Start:      "2030-01-01T09:00:00",
+		Duration:   30,
+		Timezone:   "Europe/Berlin",
+		Recurrence: &Recurrence{Type: RecurDaily},
+	})
+
+	if keys, want := payloadKeys(payload), []string{"topic", "type"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("payload fields = %v, want %v", keys, want)
+	}
+	if payload["type"] != float64(TypeInstant) {
+		t.Errorf("type = %v, want %d", payload["type"], TypeInstant)
+	}
+}
+
+func TestCreateRecurringNoFixedTimePayload(t *testing.T) {
+	payload := createdPayload(t, MeetingDetails{
+		Topic:      "Team room",
+		Type:       TypeRecurringNoFixed,
+		Start:      "2030-01-01T09:00:00",
+		Duration:   60,
+		Timezone:   "Europe/Berlin",
+		Password:   "123456",
+		Recurrence: &Recurrence{Type: RecurWeekly, WeeklyDays: "2"},
+		Settings:   &MeetingSettings{WaitingRoom: boolPtr(true)},
+	})
+
+	want := map[string]interface{}{
+		"topic":    "Team room",
+		"type":     float64(TypeRecurringNoFixed),
+		"password": "123456",
+		"settings": map[string]interface{}{"waiting_room": true},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Errorf("payload = %v, want %v", payload, want)
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
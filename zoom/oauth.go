@@ -0,0 +1,170 @@
+package zoom
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// OAuth grant types.
+const (
+	// GrantAccountCredentials is the Server-to-Server OAuth app flow and
+	// the default for an empty GrantType.
+	GrantAccountCredentials = "account_credentials"
+
+	// GrantAuthorizationCode is a user-level OAuth app, authorized once
+	// in the browser; access tokens are then obtained from RefreshToken.
+	GrantAuthorizationCode = "authorization_code"
+)
+
+// OAuthConfig holds the OAuth configuration details.
+type OAuthConfig struct {
+	GrantType    string `json:"grant_type,omitempty"`
+	AccountID    string `json:"account_id,omitempty"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// OAuthTokenResponse represents the OAuth token response.
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// tokenRequestBody returns the form body asking for an access token with
+// the configured grant.
+func (config OAuthConfig) tokenRequestBody() (string, error) {
+	form := url.Values{}
+
+	switch {
+	case config.ClientID == "":
+		return "", &ConfigError{Field: "client_id", Message: "the config has no client_id"}
+	case config.ClientSecret == "":
+		return "", &ConfigError{Field: "client_secret", Message: "the config has no client_secret"}
+	}
+
+	switch config.GrantType {
+	case "", GrantAccountCredentials:
+		if config.AccountID == "" {
+			return "", &ConfigError{Field: "account_id", Message: "the account_credentials grant needs an account_id"}
+		}
+		form.Set("grant_type", GrantAccountCredentials)
+		form.Set("account_id", config.AccountID)
+	case GrantAuthorizationCode:
+		if config.RefreshToken == "" {
+			return "", &ConfigError{Field: "refresh_token", Message: "the authorization_code grant needs a refresh_token"}
+		}
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", config.RefreshToken)
+	default:
+		return "", &ConfigError{Field: "grant_type", Message: fmt.Sprintf("unsupported grant_type %q: must be %s or %s", config.GrantType, GrantAccountCredentials, GrantAuthorizationCode)}
+	}
+
+	return form.Encode(), nil
+}
+
+// Authenticate fetches an access token, reporting whether the credentials
+// are accepted. Other methods do this on their own when needed.
+func (c *Client) Authenticate(ctx context.Context) error {
+	_, err := c.getOAuthToken(ctx)
+	return err
+}
+
+// getOAuthToken returns a valid access token. Concurrent callers wait for
+// each other, so that only one of them asks Zoom and the rest reuse its
+// token.
+func (c *Client) getOAuthToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token.valid() {
+		return c.token.AccessToken, nil
+	}
+
+	config := c.Config
+
+	// Reuse a cached token for this account while it is still valid
+	if token, ok := c.loadCachedToken(); ok {
+		c.token = token
+		return token.AccessToken, nil
+	}
+
+	// Encode Client ID and Client Secret
+	auth := base64.StdEncoding.EncodeToString([]byte(config.ClientID + ":" + config.ClientSecret))
+
+	// Create request with the required body parameters
+	data, err := config.tokenRequestBody()
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.authURL(), bytes.NewBufferString(data))
+	if err != nil {
+		return "", fmt.Errorf("creating OAuth request: %w", err)
+	}
+
+	// Add headers
+	req.Header.Add("Authorization", "Basic "+auth)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	// Make request
+	resp, err := c.doRequest(req, isRetryable)
+	if err != nil {
+		return "", fmt.Errorf("retrieving OAuth token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("reading OAuth response: %w", err)
+	}
+
+	if err := checkResponse("OAuth", resp, body); err != nil {
+		return "", err
+	}
+
+	// Decode response
+	var tokenResp OAuthTokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("decoding OAuth response: %w", err)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return "", errors.New("failed to retrieve access token")
+	}
+
+	// A rotated refresh token replaces the old one, which Zoom revokes
+	if tokenResp.RefreshToken != "" && tokenResp.RefreshToken != config.RefreshToken {
+		c.Config.RefreshToken = tokenResp.RefreshToken
+		if c.OnRefreshToken != nil {
+			// Without the new token the next run cannot authenticate, so
+			// hand it to the user rather than only warning
+			if err := c.OnRefreshToken(tokenResp.RefreshToken); err != nil {
+				return "", &AuthError{
+					Service: "OAuth",
+					Message: fmt.Sprintf("Zoom rotated the refresh token but saving it failed: %v; the old one no longer works, so store the new one yourself: %s", err, tokenResp.RefreshToken),
+				}
+			}
+		}
+	}
+
+	// Cache the token so later runs can skip the OAuth round-trip
+	token := cachedToken{
+		AccessToken: tokenResp.AccessToken,
+		ExpiresAt:   time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second),
+	}
+	c.token = token
+	if err := c.saveCachedToken(token); err != nil {
+		c.logger().Warn("could not cache OAuth token", "error", err)
+	}
+
+	return tokenResp.AccessToken, nil
+}
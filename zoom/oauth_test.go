@@ -0,0 +1,76 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentCallsShareOneToken(t *testing.T) {
+	const calls = 50
+
+	f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+		// A slow token endpoint keeps the other calls waiting on the fetch
+		time.Sleep(50 * time.Millisecond)
+		json.NewEncoder(w).Encode(OAuthTokenResponse{AccessToken: "test-token", ExpiresIn: 3600})
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want Bearer test-token", got)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1, "join_url": "https://zoom.us/j/1"}`))
+	})
+	client := f.client()
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"}); err != nil {
+				t.Errorf("CreateMeeting: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := f.tokenRequests.Load(); got != 1 {
+		t.Errorf("token requests = %d, want 1", got)
+	}
+	if got := f.apiRequests.Load(); got != calls {
+		t.Errorf("API requests = %d, want %d", got, calls)
+	}
+}
+
+func TestUnsavedRefreshTokenIsReported(t *testing.T) {
+	f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(OAuthTokenResponse{AccessToken: "test-token", RefreshToken: "rotated-refresh-token", ExpiresIn: 3600})
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API was called although the new refresh token was lost")
+	})
+	client := f.client()
+	client.Config.GrantType = GrantAuthorizationCode
+	client.Config.RefreshToken = "old-refresh-token"
+	client.OnRefreshToken = func(string) error {
+		return errors.New("config file is read-only")
+	}
+
+	_, err := client.CurrentUser(context.Background())
+	var authErr *AuthError
+	if !errors.As(err, &authErr) {
+		t.Fatalf("CurrentUser error = %v (%T), want an *AuthError", err, err)
+	}
+
+	// The CLI redacts what it prints, which must leave the token readable
+	message := Redact(err.Error())
+	for _, want := range []string{"rotated-refresh-token", "config file is read-only"} {
+		if !strings.Contains(message, want) {
+			t.Errorf("error %q does not mention %q", message, want)
+		}
+	}
+}
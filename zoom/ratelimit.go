@@ -0,0 +1,65 @@
+package zoom
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultRateLimit is Zoom's per-second limit for Medium APIs, such as
+// creating and listing meetings, on Pro and higher plans. Free accounts
+// are limited to 2.
+const DefaultRateLimit = 20
+
+// rateLimiter is a token bucket: it holds up to burst tokens, gains rate
+// of them per second and spends one for each request.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter starts with a full bucket, as many tokens as requests
+// are allowed per second, but at least one.
+func newRateLimiter(rate float64) *rateLimiter {
+	burst := math.Max(1, math.Floor(rate))
+	return &rateLimiter{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// wait takes a token, first waiting for one to be added if the bucket is
+// empty, or until ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Take the token now, even if it is only added later, so that callers
+	// waiting together are spaced out rather than woken at once
+	l.tokens--
+	var delay time.Duration
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	return sleep(ctx, delay)
+}
+
+// waitForRateLimit holds a request back as long as RateLimit requires.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.RateLimit <= 0 {
+		return nil
+	}
+
+	c.limiterOnce.Do(func() {
+		c.limiter = newRateLimiter(c.RateLimit)
+	})
+	return c.limiter.wait(ctx)
+}
@@ -0,0 +1,54 @@
+package zoom
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// timeWaits returns how long n calls to l.wait take.
+func timeWaits(t *testing.T, l *rateLimiter, n int) time.Duration {
+	t.Helper()
+
+	start := time.Now()
+	for i := 0; i < n; i++ {
+		if err := l.wait(context.Background()); err != nil {
+			t.Fatalf("wait %d: %v", i, err)
+		}
+	}
+	return time.Since(start)
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	// With a burst of 10 the last 5 of 15 calls each wait a tenth of a second
+	const rate, calls = 10, 15
+	want := time.Duration(calls-rate) * time.Second / rate
+
+	elapsed := timeWaits(t, newRateLimiter(rate), calls)
+	if elapsed < want-10*time.Millisecond {
+		t.Errorf("%d calls at %d/s took %v, want at least %v", calls, rate, elapsed, want)
+	}
+	if elapsed > want+250*time.Millisecond {
+		t.Errorf("%d calls at %d/s took %v, want about %v", calls, rate, elapsed, want)
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	// A full second's worth of requests goes out at once
+	elapsed := timeWaits(t, newRateLimiter(DefaultRateLimit), DefaultRateLimit)
+	if elapsed > 50*time.Millisecond {
+		t.Errorf("%d calls at DefaultRateLimit took %v, want no waiting", DefaultRateLimit, elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := newRateLimiter(1)
+	timeWaits(t, l, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("wait = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,24 @@
+package zoom
+
+import "regexp"
+
+// secretPatterns match credentials that could end up in an error message
+// or log line: Authorization header values (long enough not to be a
+// word), access tokens, refresh tokens, client secrets and meeting
+// passcodes in JSON or form-encoded bodies, the host's ZAK token in start
+// URLs and the passcode in join URLs.
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(\b(?:bearer|basic)\s+)[A-Za-z0-9._~+/-]{16,}=*`),
+	regexp.MustCompile(`(?i)("(?:access_token|refresh_token|client_secret|(?:[a-z0-9]+_)?password|passcode)"\s*:\s*")[^"]*`),
+	regexp.MustCompile(`(?i)(\b(?:access_token|refresh_token|client_secret|password|passcode|zak|pwd)=)[^&\s"]+`),
+}
+
+// Redact masks credentials in s, keeping the surrounding text so that the
+// message stays useful. It is applied to everything the CLI logs and to
+// error bodies from Zoom, but is safe to call on any text.
+func Redact(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "${1}<redacted>")
+	}
+	return s
+}
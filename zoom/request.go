@@ -0,0 +1,165 @@
+package zoom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+// doRequest sends req with the client's HTTP client, retrying responses
+// whose status retryable accepts, and reports timeouts in terms the user
+// can act on.
+func (c *Client) doRequest(req *http.Request, retryable func(statusCode int) bool) (*http.Response, error) {
+	log := c.logger()
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(req.Context()); err != nil {
+			return nil, err
+		}
+
+		log.Debug("HTTP request", "method", req.Method, "url", req.URL.String())
+		start := time.Now()
+		resp, err := c.HTTPClient.Do(req)
+		elapsed := time.Since(start).Round(time.Millisecond)
+
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() && req.Context().Err() == nil {
+			return nil, &timeoutError{timeout: c.HTTPClient.Timeout, err: err}
+		}
+		if proxyErr := proxyAuthError(req, resp, err); proxyErr != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return nil, proxyErr
+		}
+		if err != nil {
+			log.Debug("HTTP request failed", "method", req.Method, "url", req.URL.String(), "duration", elapsed, "error", err)
+			return nil, err
+		}
+		log.Debug("HTTP response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode, "duration", elapsed, "tracking_id", trackingID(resp))
+
+		if !retryable(resp.StatusCode) || attempt >= c.Retries {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp, attempt)
+		if delay > maxRetryDelay {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		log.Info("Zoom request failed, retrying", "status", resp.StatusCode, "delay", delay, "attempt", attempt+1, "retries", c.Retries)
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		// Rewind the body for the next attempt
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// trackingIDHeader carries the ID Zoom support asks for to find a request.
+const trackingIDHeader = "X-Zm-Trackingid"
+
+func trackingID(resp *http.Response) string {
+	return resp.Header.Get(trackingIDHeader)
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// callAPI sends an authenticated request to the Zoom REST API and returns
+// the response body. A non-nil payload is sent as JSON.
+func (c *Client) callAPI(ctx context.Context, method, url string, payload interface{}) ([]byte, error) {
+	data, _, err := c.callAPIRetrying(ctx, method, url, payload, isRetryable)
+	return data, err
+}
+
+// callAPIRetrying is callAPI retrying only the responses whose status
+// retryable accepts. It also returns the response's tracking ID.
+//
+// A token Zoom answers with HTTP 401 may have been revoked before its
+// expiry, so it is dropped and the request is sent once more with a new
+// one.
+func (c *Client) callAPIRetrying(ctx context.Context, method, url string, payload interface{}, retryable func(statusCode int) bool) ([]byte, string, error) {
+	var body []byte
+	if payload != nil {
+		var err error
+		body, err = json.Marshal(payload)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	data, tracking, token, err := c.sendAPIRequest(ctx, method, url, body, retryable)
+	var authErr *AuthError
+	if errors.As(err, &authErr) && authErr.Service == "API" && authErr.StatusCode == http.StatusUnauthorized {
+		c.dropToken(token)
+		data, tracking, _, err = c.sendAPIRequest(ctx, method, url, body, retryable)
+	}
+	return data, tracking, err
+}
+
+// sendAPIRequest makes a single authenticated request for
+// callAPIRetrying, returning the token it used too.
+func (c *Client) sendAPIRequest(ctx context.Context, method, url string, payload []byte, retryable func(statusCode int) bool) ([]byte, string, string, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	token, err := c.getOAuthToken(ctx)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	// Use OAuth token for authorization
+	req.Header.Add("Authorization", "Bearer "+token)
+	if payload != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	resp, err := c.doRequest(req, retryable)
+	if err != nil {
+		return nil, "", token, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", token, fmt.Errorf("reading response: %w", err)
+	}
+
+	if err := checkResponse("API", resp, data); err != nil {
+		return nil, "", token, err
+	}
+
+	return data, trackingID(resp), token, nil
+}
@@ -0,0 +1,227 @@
+package zoom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// cutOffResponse answers 201 but drops the connection halfway through the
+// body, as when the network fails after Zoom created the meeting.
+func cutOffResponse(t *testing.T, w http.ResponseWriter) {
+	body := `{"id": 85746065432, "topic": "Standup", "join_url": "https://zoom.us/j/85746065432"}`
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusCreated)
+	io.WriteString(w, body[:20])
+	w.(http.Flusher).Flush()
+
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		t.Errorf("hijacking the connection: %v", err)
+		return
+	}
+	conn.Close()
+}
+
+func TestCreateMeetingResponseCutOff(t *testing.T) {
+	var posts atomic.Int32
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("unexpected %s %s: creates are not retried by default", r.Method, r.URL.Path)
+			return
+		}
+		posts.Add(1)
+		cutOffResponse(t, w)
+	})
+	client := f.client()
+	client.Retries = 3
+
+	_, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"})
+	if err == nil {
+		t.Fatal("CreateMeeting succeeded, want an error")
+	}
+	if !errors.Is(err, io.ErrUnexpectedEOF) || !strings.Contains(err.Error(), "reading response") {
+		t.Errorf("error = %v, want a failure reading the response", err)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("POST requests = %d, want 1", got)
+	}
+}
+
+func TestRetryCreateFindsMeetingAfterCutOff(t *testing.T) {
+	var posts atomic.Int32
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST":
+			posts.Add(1)
+			cutOffResponse(t, w)
+		case r.URL.Path == "/v2/users/me/meetings":
+			created := time.Now().UTC().Format(time.RFC3339)
+			io.WriteString(w, `{"meetings": [{"id": 85746065432, "topic": "Standup", "type": 2, "created_at": "`+created+`"}]}`)
+		case r.URL.Path == "/v2/meetings/85746065432":
+			io.WriteString(w, `{"id": 85746065432, "topic": "Standup", "type": 2, "join_url": "https://zoom.us/j/85746065432"}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := f.client()
+	client.Retries = 1
+	client.RetryCreate = true
+
+	meeting, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup", Type: TypeScheduled})
+	if err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	if meeting.ID != 85746065432 || meeting.JoinURL != "https://zoom.us/j/85746065432" {
+		t.Errorf("meeting = %+v, want the one created before the cut-off", meeting)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("POST requests = %d, want 1: the meeting must not be created twice", got)
+	}
+}
+
+func TestRetryCreateFindsPMIMeetingAfterCutOff(t *testing.T) {
+	var posts atomic.Int32
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST":
+			posts.Add(1)
+			cutOffResponse(t, w)
+		case r.URL.Path == "/v2/users/me/meetings":
+			// Sent as scheduled with use_pmi, but listed as a PMI meeting
+			created := time.Now().UTC().Format(time.RFC3339)
+			io.WriteString(w, `{"meetings": [{"id": 5551234567, "topic": "Office hours", "type": 4, "created_at": "`+created+`"}]}`)
+		case r.URL.Path == "/v2/meetings/5551234567":
+			io.WriteString(w, `{"id": 5551234567, "topic": "Office hours", "type": 4, "join_url": "https://zoom.us/j/5551234567"}`)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client := f.client()
+	client.Retries = 1
+	client.RetryCreate = true
+
+	meeting, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Office hours", Type: TypePMI})
+	if err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	if meeting.ID != 5551234567 {
+		t.Errorf("meeting = %+v, want the PMI meeting created before the cut-off", meeting)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("POST requests = %d, want 1: the meeting must not be created twice", got)
+	}
+}
+
+func TestRetryCreateResendsAfterCutOff(t *testing.T) {
+	var posts atomic.Int32
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && posts.Add(1) == 1:
+			cutOffResponse(t, w)
+		case r.Method == "POST":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id": 85746065432, "topic": "Standup", "join_url": "https://zoom.us/j/85746065432"}`)
+		default:
+			// Nothing was created before the cut-off
+			io.WriteString(w, `{"meetings": []}`)
+		}
+	})
+	client := f.client()
+	client.Retries = 1
+	client.RetryCreate = true
+
+	meeting, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"})
+	if err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	if meeting.ID != 85746065432 {
+		t.Errorf("meeting ID = %d, want 85746065432", meeting.ID)
+	}
+	if got := posts.Load(); got != 2 {
+		t.Errorf("POST requests = %d, want 2", got)
+	}
+}
+
+func TestMayHaveCreated(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "https://api.zoom.us/v2/users/me/meetings", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", &APIError{Service: "API", StatusCode: http.StatusBadGateway}, true},
+		{"lost response", fmt.Errorf("reading response: %w", io.ErrUnexpectedEOF), true},
+		{"connection reset", &url.Error{Op: "Post", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}, true},
+		{"bad request", &APIError{Service: "API", StatusCode: http.StatusBadRequest}, false},
+		{"token endpoint down", &APIError{Service: "OAuth", StatusCode: http.StatusServiceUnavailable}, false},
+		{"connection refused", dialErr, false},
+		{"dial timeout", &timeoutError{timeout: time.Second, err: dialErr}, false},
+		{"unknown host", &url.Error{Op: "Post", Err: &net.OpError{Op: "dial", Err: &net.DNSError{Err: "no such host", Name: "api.zoom.us", IsNotFound: true}}}, false},
+	}
+	for _, tt := range tests {
+		if got := mayHaveCreated(context.Background(), tt.err); got != tt.want {
+			t.Errorf("%s: mayHaveCreated(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRetryCreateResendsAfterRefusedConnection(t *testing.T) {
+	var posts, lists atomic.Int32
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			lists.Add(1)
+			io.WriteString(w, `{"meetings": []}`)
+			return
+		}
+		posts.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": 85746065432, "topic": "Standup", "join_url": "https://zoom.us/j/85746065432"}`)
+	})
+	client := f.client()
+	client.Retries = 1
+	client.RetryCreate = true
+
+	// The first attempt to reach the API is refused, as when the network
+	// is not up yet; the token request goes through
+	transport := f.Client().Transport
+	var dials atomic.Int32
+	client.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if strings.HasPrefix(r.URL.Path, "/v2/") && dials.Add(1) == 1 {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+		}
+		return transport.RoundTrip(r)
+	})}
+
+	meeting, err := client.CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"})
+	if err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	if meeting.ID != 85746065432 {
+		t.Errorf("meeting ID = %d, want 85746065432", meeting.ID)
+	}
+	if got := posts.Load(); got != 1 {
+		t.Errorf("POST requests reaching Zoom = %d, want 1", got)
+	}
+	if got := lists.Load(); got != 0 {
+		t.Errorf("meeting lookups = %d, want none for a request that was never sent", got)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,43 @@
+package zoom
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// initialRetryDelay doubles after every attempt.
+	initialRetryDelay = time.Second
+
+	// maxRetryDelay caps how long a single Retry-After wait may be; longer
+	// waits (e.g. a daily quota reset) are reported instead of slept through.
+	maxRetryDelay = time.Minute
+)
+
+// isRetryable reports whether a response status indicates a transient
+// failure: rate limiting or a server-side error.
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// isRetryableCreate is isRetryable for creating a meeting, where only
+// rate limiting is known to mean nothing was created.
+func isRetryableCreate(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests
+}
+
+// retryDelay returns how long to wait before the next attempt, preferring
+// the server's Retry-After header over exponential backoff.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return time.Until(date)
+		}
+	}
+
+	return initialRetryDelay << attempt
+}
@@ -0,0 +1,33 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MeetingTemplate is a meeting template saved in the Zoom web portal.
+type MeetingTemplate struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Type is 1 for a meeting template, 2 for an admin template.
+	Type int `json:"type"`
+}
+
+// ListMeetingTemplates returns the meeting templates of the client's user,
+// whose IDs MeetingDetails.TemplateID takes.
+func (c *Client) ListMeetingTemplates(ctx context.Context) ([]MeetingTemplate, error) {
+	data, err := c.callAPI(ctx, "GET", c.userURL(c.User)+"/meeting_templates", nil)
+	if err != nil {
+		return nil, c.userNotFound(err)
+	}
+
+	var list struct {
+		Templates []MeetingTemplate `json:"templates"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("decoding meeting templates: %w", err)
+	}
+
+	return list.Templates, nil
+}
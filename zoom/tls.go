@@ -0,0 +1,47 @@
+package zoom
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DefaultMinTLSVersion is the oldest TLS version NewTransport accepts.
+const DefaultMinTLSVersion = tls.VersionTLS12
+
+// ParseFingerprint decodes a SHA-256 certificate fingerprint given in hex,
+// with or without colons, as "openssl x509 -fingerprint -sha256" prints it.
+func ParseFingerprint(fingerprint string) ([]byte, error) {
+	digest, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil || len(digest) != sha256.Size {
+		return nil, fmt.Errorf("invalid certificate fingerprint %q: must be a SHA-256 digest in hex", fingerprint)
+	}
+	return digest, nil
+}
+
+// PinCertificates makes transport accept only servers whose certificate
+// has one of the SHA-256 fingerprints, on top of the usual verification,
+// failing every other connection. Zoom's API and OAuth hosts have
+// different certificates, so both need to be pinned.
+func PinCertificates(transport *http.Transport, fingerprints [][]byte) {
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: DefaultMinTLSVersion}
+	}
+	transport.TLSClientConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("certificate pinning: the server sent no certificate")
+		}
+
+		digest := sha256.Sum256(cs.PeerCertificates[0].Raw)
+		for _, fingerprint := range fingerprints {
+			if string(fingerprint) == string(digest[:]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("certificate pinning: the certificate of %s has fingerprint %X, which is not pinned", cs.ServerName, digest)
+	}
+}
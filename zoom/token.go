@@ -0,0 +1,106 @@
+package zoom
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/optiowl/zoom-meeting/internal/atomicfile"
+)
+
+// tokenExpiryMargin is how long before expiry a cached token stops being reused.
+const tokenExpiryMargin = 60 * time.Second
+
+// cachedToken is an OAuth access token persisted between runs.
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// tokenCache maps account IDs to their cached tokens.
+type tokenCache map[string]cachedToken
+
+func (c *Client) readTokenCache() tokenCache {
+	cache := tokenCache{}
+
+	if c.TokenCachePath == "" {
+		return cache
+	}
+
+	fileContent, err := os.ReadFile(c.TokenCachePath)
+	if err != nil {
+		return cache
+	}
+
+	// A corrupt cache is treated as empty and overwritten on the next save.
+	if err := json.Unmarshal(fileContent, &cache); err != nil {
+		return tokenCache{}
+	}
+
+	return cache
+}
+
+// tokenCacheKey identifies the account in the cache; user-level apps have
+// no account ID, so their client ID is used instead.
+func (c *Client) tokenCacheKey() string {
+	if c.Config.AccountID != "" {
+		return c.Config.AccountID
+	}
+	return "client:" + c.Config.ClientID
+}
+
+// valid reports whether the token is set and not within
+// tokenExpiryMargin of expiring.
+func (t cachedToken) valid() bool {
+	return t.AccessToken != "" && time.Now().Add(tokenExpiryMargin).Before(t.ExpiresAt)
+}
+
+// loadCachedToken returns the cached token for the account if it is
+// still valid.
+func (c *Client) loadCachedToken() (cachedToken, bool) {
+	token, ok := c.readTokenCache()[c.tokenCacheKey()]
+	if !ok || !token.valid() {
+		return cachedToken{}, false
+	}
+	return token, true
+}
+
+func (c *Client) saveCachedToken(token cachedToken) error {
+	if c.TokenCachePath == "" {
+		return nil
+	}
+
+	cache := c.readTokenCache()
+	cache[c.tokenCacheKey()] = token
+	return c.writeTokenCache(cache)
+}
+
+// dropToken forgets token, which Zoom rejected, both in memory and in the
+// cache file, unless another call has replaced it already.
+func (c *Client) dropToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token.AccessToken == token {
+		c.token = cachedToken{}
+	}
+	if c.TokenCachePath == "" {
+		return
+	}
+
+	cache := c.readTokenCache()
+	if cached, ok := cache[c.tokenCacheKey()]; ok && cached.AccessToken == token {
+		delete(cache, c.tokenCacheKey())
+		if err := c.writeTokenCache(cache); err != nil {
+			c.logger().Warn("could not remove the rejected OAuth token from the cache", "error", err)
+		}
+	}
+}
+
+func (c *Client) writeTokenCache(cache tokenCache) error {
+	fileContent, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(c.TokenCachePath, fileContent)
+}
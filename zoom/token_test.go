@@ -0,0 +1,84 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRevokedTokenIsReplaced(t *testing.T) {
+	var tokens atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		n := tokens.Add(1)
+		json.NewEncoder(w).Encode(OAuthTokenResponse{AccessToken: "token-" + strconv.Itoa(int(n)), ExpiresIn: 3600})
+	})
+	mux.HandleFunc("/v2/users/me", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code": 124, "message": "Invalid access token."}`))
+			return
+		}
+		w.Write([]byte(`{"id": "u1", "email": "host@example.com"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cachePath := filepath.Join(t.TempDir(), "tokens.json")
+	client := &Client{
+		Config:         OAuthConfig{AccountID: "a", ClientID: "b", ClientSecret: "c"},
+		BaseURL:        server.URL + "/v2",
+		AuthURL:        server.URL + "/oauth/token",
+		HTTPClient:     server.Client(),
+		TokenCachePath: cachePath,
+	}
+
+	user, err := client.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("CurrentUser: %v", err)
+	}
+	if user.Email != "host@example.com" {
+		t.Errorf("email = %q, want host@example.com", user.Email)
+	}
+	if got := tokens.Load(); got != 2 {
+		t.Errorf("token requests = %d, want 2", got)
+	}
+
+	if cached, ok := client.loadCachedToken(); !ok || cached.AccessToken != "token-2" {
+		t.Errorf("cached token = %q, %v; want token-2", cached.AccessToken, ok)
+	}
+	info, err := os.Stat(cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("cache file mode = %o, want 600", perm)
+	}
+}
+
+func TestTokenCacheIsPrivate(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(cachePath, []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{Config: OAuthConfig{AccountID: "a"}, TokenCachePath: cachePath}
+	if err := client.saveCachedToken(cachedToken{AccessToken: "t", ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := os.Stat(cachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("cache file mode = %o, want 600", perm)
+	}
+}
@@ -0,0 +1,92 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// User types Zoom reports for a user.
+const (
+	UserBasic      = 1
+	UserLicensed   = 2
+	UserUnassigned = 4
+	UserNone       = 99
+)
+
+// User is the part of a Zoom user the tool uses.
+type User struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Type      int    `json:"type"`
+	RoleName  string `json:"role_name,omitempty"`
+	AccountID string `json:"account_id,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	// PlanUnitedType is set for accounts on a Zoom United plan.
+	PlanUnitedType string `json:"plan_united_type,omitempty"`
+}
+
+// UserSettings is the part of a user's settings the tool checks.
+type UserSettings struct {
+	Recording RecordingSettings `json:"recording"`
+}
+
+// RecordingSettings are the user's recording settings. Each field is the
+// setting's state, nil when Zoom leaves it out.
+type RecordingSettings struct {
+	CloudRecording *bool `json:"cloud_recording,omitempty"`
+
+	// AudioTranscript is "Create audio transcript": Zoom transcribes the
+	// audio of cloud recordings. There is no such setting per meeting.
+	AudioTranscript *bool `json:"recording_audio_transcript,omitempty"`
+}
+
+// TypeName describes the user's type, which decides the plan their
+// meetings run on.
+func (u *User) TypeName() string {
+	switch u.Type {
+	case UserBasic:
+		return "Basic"
+	case UserLicensed:
+		return "Licensed"
+	case UserUnassigned:
+		return "Unassigned without Meetings Basic"
+	case UserNone:
+		return "None"
+	}
+	return fmt.Sprintf("type %d", u.Type)
+}
+
+// CurrentUser returns the user the credentials act as, /users/me. It is
+// the cheapest way to check that the credentials work.
+func (c *Client) CurrentUser(ctx context.Context) (*User, error) {
+	data, err := c.callAPI(ctx, "GET", c.baseURL()+"/users/me", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("decoding user: %w", err)
+	}
+
+	return &user, nil
+}
+
+// UserSettings returns the settings of the client's user. It needs the
+// user:read:settings scope, or user:read:admin for another user.
+func (c *Client) UserSettings(ctx context.Context) (*UserSettings, error) {
+	data, err := c.callAPI(ctx, "GET", c.userURL(c.User)+"/settings", nil)
+	if err != nil {
+		return nil, c.userNotFound(err)
+	}
+
+	var settings UserSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("decoding user settings: %w", err)
+	}
+
+	return &settings, nil
+}
@@ -0,0 +1,85 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TypeWebinar is a webinar scheduled once, the only kind created here.
+const TypeWebinar = 5
+
+// WebinarDetails holds information about a webinar. Webinars need the
+// webinar add-on on the account. Empty fields are left out.
+type WebinarDetails struct {
+	Topic    string `json:"topic,omitempty"`
+	Type     int    `json:"type,omitempty"`
+	Start    string `json:"start_time,omitempty"`
+	Duration int    `json:"duration,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+	Password string `json:"password,omitempty"`
+	Agenda   string `json:"agenda,omitempty"`
+
+	// Settings is only sent when at least one setting is chosen.
+	Settings *WebinarSettings `json:"settings,omitempty"`
+}
+
+// WebinarSettings holds the optional settings object of a webinar. A nil
+// field is left out so that Zoom applies the host's own webinar settings.
+type WebinarSettings struct {
+	HostVideo *bool `json:"host_video,omitempty"`
+
+	// ApprovalType turns on registration when set to ApprovalAutomatic or
+	// ApprovalManual, as for meetings.
+	ApprovalType *int `json:"approval_type,omitempty"`
+
+	// AutoRecording is RecordingNone, RecordingLocal or RecordingCloud.
+	AutoRecording string `json:"auto_recording,omitempty"`
+}
+
+// Webinar is a webinar as returned by the Zoom API.
+type Webinar struct {
+	ID        int64  `json:"id"`
+	Topic     string `json:"topic"`
+	Type      int    `json:"type"`
+	StartTime string `json:"start_time,omitempty"`
+	Duration  int    `json:"duration,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	Agenda    string `json:"agenda,omitempty"`
+	Password  string `json:"password,omitempty"`
+	JoinURL   string `json:"join_url"`
+	StartURL  string `json:"start_url,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+
+	// TrackingID is the ID of the request that created the webinar, for
+	// Zoom support; it is not part of Zoom's webinar object.
+	TrackingID string `json:"tracking_id,omitempty"`
+
+	// RegistrationURL is only set for webinars that require registration.
+	RegistrationURL string `json:"registration_url,omitempty"`
+
+	Settings *WebinarSettings `json:"settings,omitempty"`
+}
+
+// WebinarsURL returns the webinars endpoint of the client's user.
+func (c *Client) WebinarsURL() string {
+	return c.userURL(c.User) + "/webinars"
+}
+
+// CreateWebinar schedules a webinar for the client's user. Like
+// CreateMeeting it only retries HTTP 429, since after a server error Zoom
+// may have created the webinar anyway.
+func (c *Client) CreateWebinar(ctx context.Context, details WebinarDetails) (*Webinar, error) {
+	data, tracking, err := c.callAPIRetrying(ctx, "POST", c.WebinarsURL(), details, isRetryableCreate)
+	if err != nil {
+		return nil, c.userNotFound(err)
+	}
+
+	var webinar Webinar
+	if err := json.Unmarshal(data, &webinar); err != nil {
+		return nil, fmt.Errorf("decoding webinar: %w", err)
+	}
+	webinar.TrackingID = tracking
+
+	return &webinar, nil
+}
@@ -0,0 +1,38 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCreateWebinar(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v2/users/me/webinars" {
+			t.Errorf("request = %s %s, want POST /v2/users/me/webinars", r.Method, r.URL.Path)
+		}
+
+		var details WebinarDetails
+		if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		if details.Type != TypeWebinar {
+			t.Errorf("type = %d, want %d", details.Type, TypeWebinar)
+		}
+		w.Header().Set(trackingIDHeader, "tracking-webinar")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Webinar{ID: 91234567890, Topic: details.Topic, JoinURL: "https://zoom.us/j/91234567890"})
+	})
+
+	webinar, err := f.client().CreateWebinar(context.Background(), WebinarDetails{Topic: "Product launch", Type: TypeWebinar, Duration: 90})
+	if err != nil {
+		t.Fatalf("CreateWebinar: %v", err)
+	}
+	if webinar.ID != 91234567890 || webinar.Topic != "Product launch" {
+		t.Errorf("webinar = %+v", webinar)
+	}
+	if webinar.TrackingID != "tracking-webinar" {
+		t.Errorf("TrackingID = %q, want tracking-webinar", webinar.TrackingID)
+	}
+}
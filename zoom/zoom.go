@@ -0,0 +1,143 @@
+// Package zoom creates and manages Zoom meetings through the Zoom REST API,
+// authenticating as a Server-to-Server OAuth app.
+//
+// The simplest use is the CreateMeeting function:
+//
+//	meeting, err := zoom.CreateMeeting(ctx, config, zoom.MeetingDetails{
+//		Topic:    "Standup",
+//		Type:     zoom.TypeScheduled,
+//		Start:    "2025-06-01T09:00:00Z",
+//		Duration: 15,
+//	})
+//
+// Programs making several calls should create a Client with NewClient and
+// reuse it, so that the OAuth token is fetched only once.
+package zoom
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Endpoints of the commercial Zoom cloud, used unless a Client overrides them.
+const (
+	DefaultBaseURL = "https://api.zoom.us/v2"
+	DefaultAuthURL = "https://zoom.us/oauth/token"
+)
+
+const (
+	// DefaultTimeout bounds every request made by a client from NewClient.
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultRetries is how many times a client from NewClient retries a
+	// request that failed with HTTP 429 or 5xx.
+	DefaultRetries = 3
+)
+
+// Client talks to the Zoom API on behalf of one account. It is safe for
+// concurrent use; its fields may be changed after NewClient but not while
+// requests are in flight, and it must not be copied after first use.
+type Client struct {
+	// Config holds the account's OAuth credentials.
+	Config OAuthConfig
+
+	// User is the ID or email address of the user whose meetings are
+	// created and listed. Empty means the app's own user ("me");
+	// other users need an account-level app.
+	User string
+
+	// BaseURL is the REST API root, without a trailing slash, and AuthURL
+	// the OAuth token endpoint. Empty means DefaultBaseURL and
+	// DefaultAuthURL; tests point them at a local server.
+	BaseURL string
+	AuthURL string
+
+	// HTTPClient sends every request, both for tokens and API calls.
+	HTTPClient *http.Client
+
+	// Retries is how many times a request failing with HTTP 429 or 5xx
+	// is re-sent, with exponential backoff or the server's Retry-After.
+	Retries int
+
+	// RateLimit is the most requests per second sent to Zoom, retries
+	// included, to stay clear of HTTP 429 during bulk operations; 0 means
+	// no limit. It is fixed by the first request.
+	RateLimit float64
+
+	// RetryCreate lets CreateMeeting retry after a 5xx, a lost response or
+	// a failure to connect too. Zoom may have created the meeting anyway,
+	// so it is off by default; when on, each retry after a request that
+	// reached Zoom first looks for the meeting among the user's meetings
+	// and returns it instead of creating a duplicate.
+	RetryCreate bool
+
+	// TokenCachePath, when set, names a file where access tokens are
+	// kept between processes, keyed by account ID.
+	TokenCachePath string
+
+	// OnRefreshToken, when set, is called with the new refresh token each
+	// time the authorization_code grant rotates it, so that it can be
+	// stored for the next run. The old token stops working once rotated,
+	// so if it returns an error the token fetch fails with an *AuthError
+	// that includes the new one.
+	OnRefreshToken func(refreshToken string) error
+
+	// Logger receives diagnostics; nil means slog.Default().
+	Logger *slog.Logger
+
+	// tokenMu serializes token lookups, so that concurrent calls share a
+	// single fetch of the token kept in token.
+	tokenMu sync.Mutex
+	token   cachedToken
+
+	limiterOnce sync.Once
+	limiter     *rateLimiter
+}
+
+// NewClient returns a client for the account with DefaultTimeout,
+// DefaultRetries and DefaultRateLimit and no on-disk token cache.
+func NewClient(config OAuthConfig) *Client {
+	return &Client{
+		Config:     config,
+		HTTPClient: &http.Client{Timeout: DefaultTimeout, Transport: NewTransport(nil)},
+		Retries:    DefaultRetries,
+		RateLimit:  DefaultRateLimit,
+	}
+}
+
+// CreateMeeting creates a meeting for the account's own user with a new
+// default Client.
+func CreateMeeting(ctx context.Context, config OAuthConfig, details MeetingDetails) (*Meeting, error) {
+	return NewClient(config).CreateMeeting(ctx, details)
+}
+
+// GetMeeting returns the meeting with the given ID with a new default
+// Client.
+func GetMeeting(ctx context.Context, config OAuthConfig, id string) (*Meeting, error) {
+	return NewClient(config).GetMeeting(ctx, id)
+}
+
+func (c *Client) baseURL() string {
+	if c.BaseURL != "" {
+		return strings.TrimSuffix(c.BaseURL, "/")
+	}
+	return DefaultBaseURL
+}
+
+func (c *Client) authURL() string {
+	if c.AuthURL != "" {
+		return c.AuthURL
+	}
+	return DefaultAuthURL
+}
+
+func (c *Client) logger() *slog.Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	return slog.Default()
+}
@@ -0,0 +1,131 @@
+package zoom
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+var testConfig = OAuthConfig{AccountID: "account", ClientID: "client", ClientSecret: "secret"}
+
+// fakeZoom is a local stand-in for Zoom: the OAuth token endpoint under
+// /oauth/token and the REST API under /v2.
+type fakeZoom struct {
+	*httptest.Server
+	tokenRequests atomic.Int32
+	apiRequests   atomic.Int32
+}
+
+// newFakeZoom starts a fakeZoom whose API is served by api. A nil token
+// handler hands out "test-token" to the testConfig credentials.
+func newFakeZoom(t *testing.T, token, api http.HandlerFunc) *fakeZoom {
+	t.Helper()
+
+	if token == nil {
+		token = func(w http.ResponseWriter, r *http.Request) {
+			id, secret, _ := r.BasicAuth()
+			if id != testConfig.ClientID || secret != testConfig.ClientSecret || r.FormValue("account_id") != testConfig.AccountID {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"reason": "Invalid client_id or client_secret", "error": "invalid_client"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(OAuthTokenResponse{AccessToken: "test-token", ExpiresIn: 3600})
+		}
+	}
+
+	f := &fakeZoom{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		f.tokenRequests.Add(1)
+		token(w, r)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		f.apiRequests.Add(1)
+		api(w, r)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// client returns a Client for the testConfig account talking to f.
+func (f *fakeZoom) client() *Client {
+	return &Client{
+		Config:     testConfig,
+		BaseURL:    f.URL + "/v2",
+		AuthURL:    f.URL + "/oauth/token",
+		HTTPClient: f.Client(),
+	}
+}
+
+func TestCreateMeeting(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v2/users/me/meetings" {
+			t.Errorf("request = %s %s, want POST /v2/users/me/meetings", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want Bearer test-token", got)
+		}
+
+		var details MeetingDetails
+		if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		w.Header().Set(trackingIDHeader, "tracking-1")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(Meeting{ID: 85746065432, Topic: details.Topic, JoinURL: "https://zoom.us/j/85746065432"})
+	})
+
+	meeting, err := f.client().CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup", Type: TypeScheduled, Duration: 15})
+	if err != nil {
+		t.Fatalf("CreateMeeting: %v", err)
+	}
+	if meeting.ID != 85746065432 || meeting.Topic != "Standup" || meeting.JoinURL != "https://zoom.us/j/85746065432" {
+		t.Errorf("meeting = %+v", meeting)
+	}
+	if meeting.TrackingID != "tracking-1" {
+		t.Errorf("TrackingID = %q, want tracking-1", meeting.TrackingID)
+	}
+}
+
+func TestCreateMeetingTokenRejected(t *testing.T) {
+	f := newFakeZoom(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"reason": "Invalid client_id or client_secret", "error": "invalid_client"}`))
+	}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the API was called without a token")
+	})
+
+	_, err := f.client().CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"})
+	if err == nil {
+		t.Fatal("CreateMeeting succeeded, want an error")
+	}
+	if !IsAuthError(err) {
+		t.Errorf("IsAuthError(%v) = false, want true", err)
+	}
+	if !strings.Contains(err.Error(), "Invalid client_id or client_secret") {
+		t.Errorf("error %q does not carry Zoom's reason", err)
+	}
+}
+
+func TestCreateMeetingMalformedResponse(t *testing.T) {
+	f := newFakeZoom(t, nil, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 85746065432, "join_url": `))
+	})
+
+	_, err := f.client().CreateMeeting(context.Background(), MeetingDetails{Topic: "Standup"})
+	if err == nil {
+		t.Fatal("CreateMeeting succeeded, want an error")
+	}
+	if !strings.Contains(err.Error(), "decoding meeting") {
+		t.Errorf("error = %q, want a decoding error", err)
+	}
+	if IsAPIError(err) {
+		t.Errorf("IsAPIError(%v) = true, want false for a 2xx response", err)
+	}
+}